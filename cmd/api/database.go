@@ -52,6 +52,7 @@ func runMigrations(db *gorm.DB, log *zap.Logger) error {
 
 	if err := db.AutoMigrate(
 		&domain.DatabaseConnection{},
+		&domain.AuditConvention{},
 	); err != nil {
 		return err
 	}
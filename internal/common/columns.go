@@ -0,0 +1,97 @@
+package common
+
+import (
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// ScanColumns reads every row returned by a connector's ReadSchema query.
+// The column order must match the TableColumnData queries in the query package.
+func ScanColumns(rows *sql.Rows) ([]domain.SqlData, error) {
+	var columns []domain.SqlData
+
+	for rows.Next() {
+		var col domain.SqlData
+		err := rows.Scan(
+			&col.Ordinal,
+			&col.ColumnName,
+			&col.IsNullable,
+			&col.CharacterMaximumLength,
+			&col.DataType,
+			&col.ColumnKey,
+			&col.ColumnComment,
+			&col.IsIdentity,
+			&col.IsGenerated,
+			&col.EnumValues,
+			&col.ReferencedTable,
+			&col.ReferencedColumn,
+			&col.NumericPrecision,
+			&col.NumericScale,
+		)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return columns, nil
+}
+
+// EnumValues splits the comma separated ENUM_VALUES column into its labels.
+func EnumValues(col domain.SqlData) []string {
+	if !col.EnumValues.Valid || col.EnumValues.String == "" {
+		return nil
+	}
+	return strings.Split(col.EnumValues.String, ",")
+}
+
+// IsPrimaryKey reports whether the column is part of its table's primary key.
+func IsPrimaryKey(col domain.SqlData) bool {
+	return strings.Contains(col.ColumnKey, "PRI")
+}
+
+// PrimaryKeys returns the columns that make up the table's primary key, in
+// column order.
+func PrimaryKeys(columns []domain.SqlData) []domain.SqlData {
+	var primaryKeys []domain.SqlData
+
+	for _, col := range columns {
+		if IsPrimaryKey(col) {
+			primaryKeys = append(primaryKeys, col)
+		}
+	}
+
+	return primaryKeys
+}
+
+// BundledReference returns the table a foreign key column references when
+// that table is generated in the same request, so a relation can point at a
+// type that exists.
+func BundledReference(col domain.SqlData, req domain.TypeRequest) (string, bool) {
+	if !col.ReferencedTable.Valid || !col.ReferencedColumn.Valid {
+		return "", false
+	}
+	for _, name := range req.TableNames {
+		if strings.EqualFold(name, col.ReferencedTable.String) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// versionColumns are the column names conventionally used for optimistic
+// locking.
+var versionColumns = []string{"version", "row_version", "lock_version", "opt_lock"}
+
+// IsVersionColumn reports whether the column name is a conventional
+// optimistic locking column.
+func IsVersionColumn(name string) bool {
+	return slices.Contains(versionColumns, strings.ToLower(name))
+}
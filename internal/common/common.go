@@ -51,3 +51,16 @@ func ToSnakeCase(str string) string {
 	s = strings.Trim(s, "_")
 	return s
 }
+
+// QuoteIdentifier quotes a table or column name in the dialect's syntax, so
+// reserved words such as user or order can be used as names.
+func QuoteIdentifier(dbType, name string) string {
+	switch strings.ToLower(dbType) {
+	case "mssql":
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	case "postgres":
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	default:
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+}
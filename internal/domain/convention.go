@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+)
+
+// AuditConvention describes a team's audit columns and how mappers populate
+// them. A value of "param" (or empty) binds a parameter named after the
+// column, "now" uses the database's current timestamp, and anything else is
+// written as a SQL expression such as NOW() or GETDATE().
+type AuditConvention struct {
+	ConventionID    uint64    `gorm:"column:convention_id;primaryKey;autoIncrement" json:"conventionId"`
+	Name            string    `gorm:"column:name;size:100;not null;uniqueIndex" json:"name"`
+	CreatedAtColumn string    `gorm:"column:created_at_column;size:100" json:"createdAtColumn"`
+	CreatedAtValue  string    `gorm:"column:created_at_value;size:100" json:"createdAtValue"`
+	CreatedByColumn string    `gorm:"column:created_by_column;size:100" json:"createdByColumn"`
+	CreatedByValue  string    `gorm:"column:created_by_value;size:100" json:"createdByValue"`
+	CreatedIpColumn string    `gorm:"column:created_ip_column;size:100" json:"createdIpColumn"`
+	CreatedIpValue  string    `gorm:"column:created_ip_value;size:100" json:"createdIpValue"`
+	UpdatedAtColumn string    `gorm:"column:updated_at_column;size:100" json:"updatedAtColumn"`
+	UpdatedAtValue  string    `gorm:"column:updated_at_value;size:100" json:"updatedAtValue"`
+	UpdatedByColumn string    `gorm:"column:updated_by_column;size:100" json:"updatedByColumn"`
+	UpdatedByValue  string    `gorm:"column:updated_by_value;size:100" json:"updatedByValue"`
+	UpdatedIpColumn string    `gorm:"column:updated_ip_column;size:100" json:"updatedIpColumn"`
+	UpdatedIpValue  string    `gorm:"column:updated_ip_value;size:100" json:"updatedIpValue"`
+	DeletedColumn   string    `gorm:"column:deleted_column;size:100" json:"deletedColumn"`
+	DeletedValue    string    `gorm:"column:deleted_value;size:100" json:"deletedValue"`
+	NotDeletedValue string    `gorm:"column:not_deleted_value;size:100" json:"notDeletedValue"`
+	VersionColumn   string    `gorm:"column:version_column;size:100" json:"versionColumn"`
+	IgnoredPrefixes string    `gorm:"column:ignored_prefixes;size:255" json:"ignoredPrefixes"`
+	CreatedAt       time.Time `gorm:"column:created_at;autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updatedAt"`
+}
+
+func (c *AuditConvention) TableName() string {
+	return "audit_conventions"
+}
+
+// DefaultAuditConvention is used when a mapper request names no convention.
+func DefaultAuditConvention() AuditConvention {
+	return AuditConvention{
+		Name:            "default",
+		CreatedAtColumn: "insert_dtm",
+		CreatedAtValue:  "now",
+		CreatedByColumn: "insert_user_id",
+		CreatedByValue:  "param",
+		CreatedIpColumn: "insert_ip",
+		CreatedIpValue:  "param",
+		UpdatedAtColumn: "update_dtm",
+		UpdatedAtValue:  "now",
+		UpdatedByColumn: "update_user_id",
+		UpdatedByValue:  "param",
+		UpdatedIpColumn: "update_ip",
+		UpdatedIpValue:  "param",
+		IgnoredPrefixes: "insert_,update_,delete_",
+	}
+}
@@ -31,6 +31,13 @@ type SqlData struct {
 	DataType               string         `json:"dataType"`
 	ColumnKey              string         `json:"columnKey"`
 	ColumnComment          sql.NullString `json:"columnComment"`
+	IsIdentity             string         `json:"isIdentity"`
+	IsGenerated            string         `json:"isGenerated"`
+	EnumValues             sql.NullString `json:"enumValues"`
+	ReferencedTable        sql.NullString `json:"referencedTable"`
+	ReferencedColumn       sql.NullString `json:"referencedColumn"`
+	NumericPrecision       sql.NullInt16  `json:"numericPrecision"`
+	NumericScale           sql.NullInt16  `json:"numericScale"`
 }
 
 type Stats struct {
@@ -61,6 +68,7 @@ type TypeRequest struct {
 
 type MapperRequest struct {
 	ConnectionId uint            `json:"connectionId"`
+	ConventionId uint            `json:"conventionId,omitempty"`
 	Options      json.RawMessage `json:"options"`
 	TargetType   string          `json:"targetType"`
 	TableName    string          `json:"tableName"`
@@ -76,12 +84,20 @@ type JavaOptions struct {
 	SwaggerAnnotations bool `json:"swaggerAnnotations,omitempty"`
 	Serializable       bool `json:"serializable,omitempty"`
 	JacksonAnnotations bool `json:"jacksonAnnotations,omitempty"`
+	BeanValidation     bool `json:"beanValidation,omitempty"`
 	ExtraSpacing       bool `json:"extraSpacing,omitempty"`
 }
 
+type JpaEntityOptions struct {
+	JavaOptions
+	Schema        string `json:"schema,omitempty"`
+	VersionColumn string `json:"versionColumn,omitempty"`
+}
+
 type RecordOptions struct {
 	SwaggerAnnotations bool `json:"swaggerAnnotations,omitempty"`
 	JacksonAnnotations bool `json:"jacksonAnnotations,omitempty"`
+	BeanValidation     bool `json:"beanValidation,omitempty"`
 	Builder            bool `json:"builder,omitempty"`
 	ExtraSpacing       bool `json:"extraSpacing,omitempty"`
 }
@@ -93,6 +109,7 @@ type CSharpDtoOptions struct {
 	Getter              bool `json:"getter,omitempty"`
 	Setter              bool `json:"setter,omitempty"`
 	JsonPropertyName    bool `json:"jsonPropertyName,omitempty"`
+	DataAnnotations     bool `json:"dataAnnotations,omitempty"`
 }
 
 type CSharpRecordOptions struct {
@@ -102,18 +119,32 @@ type CSharpRecordOptions struct {
 	JsonPropertyName    bool `json:"jsonPropertyName,omitempty"`
 	Positional          bool `json:"positional,omitempty"`
 	WithInit            bool `json:"withInit,omitempty"`
+	DataAnnotations     bool `json:"dataAnnotations,omitempty"`
+}
+
+type CSharpValidatorOptions struct {
+	CamelCaseProperties bool `json:"camelCaseProperties,omitempty"`
+	ExtraSpacing        bool `json:"extraSpacing,omitempty"`
+}
+
+type EfCoreOptions struct {
+	Namespace    string `json:"namespace,omitempty"`
+	ContextName  string `json:"contextName,omitempty"`
+	Schema       string `json:"schema,omitempty"`
+	ExtraSpacing bool   `json:"extraSpacing,omitempty"`
 }
 
 type GoStructAdvancedOptions struct {
-	JsonTags         bool `json:"jsonTags"`
-	OmitEmpty        bool `json:"omitempty"`
-	PointerFields    bool `json:"pointerFields"`
-	ExportFields     bool `json:"exportFields"`
-	Comments         bool `json:"comments"`
-	ValidateTags     bool `json:"validateTags"`
-	DBTags           bool `json:"dbTags"`
-	MapstructureTags bool `json:"mapstructureTags"`
-	ExtraSpacing     bool `json:"extraSpacing"`
+	JsonTags         bool   `json:"jsonTags"`
+	OmitEmpty        bool   `json:"omitempty"`
+	PointerFields    bool   `json:"pointerFields"`
+	ExportFields     bool   `json:"exportFields"`
+	Comments         bool   `json:"comments"`
+	ValidateTags     bool   `json:"validateTags"`
+	DBTags           bool   `json:"dbTags"`
+	MapstructureTags bool   `json:"mapstructureTags"`
+	ExtraSpacing     bool   `json:"extraSpacing"`
+	NullTypes        string `json:"nullTypes"`
 }
 
 type PythonDataclassOptions struct {
@@ -146,6 +177,8 @@ type PythonPydanticOptions struct {
 	UseEnumValues              bool `json:"useEnumValues"`
 	StrictTypes                bool `json:"strictTypes"`
 	ArbitraryTypesAllowed      bool `json:"arbitraryTypesAllowed"`
+
+	Version string `json:"version"`
 }
 
 type PythonClassOptions struct {
@@ -168,12 +201,100 @@ type PythonTypedDictOptions struct {
 
 	Total bool `json:"total"`
 }
+
+type PythonOrmOptions struct {
+	Comments     bool `json:"comments"`
+	Docstrings   bool `json:"docstrings"`
+	ExtraSpacing bool `json:"extraSpacing"`
+
+	Schema string `json:"schema"`
+}
+
+type PythonDjangoOptions struct {
+	Comments     bool `json:"comments"`
+	Docstrings   bool `json:"docstrings"`
+	ExtraSpacing bool `json:"extraSpacing"`
+
+	Unmanaged bool   `json:"unmanaged"`
+	OnDelete  string `json:"onDelete"`
+}
+
+type PythonMsgspecOptions struct {
+	OptionalFields bool `json:"optionalFields"`
+	Comments       bool `json:"comments"`
+	Docstrings     bool `json:"docstrings"`
+	ExtraSpacing   bool `json:"extraSpacing"`
+
+	Rename       string `json:"rename"`
+	OmitDefaults bool   `json:"omitDefaults"`
+	Frozen       bool   `json:"frozen"`
+	Constraints  bool   `json:"constraints"`
+}
+
+type PythonAttrsOptions struct {
+	OptionalFields bool `json:"optionalFields"`
+	Comments       bool `json:"comments"`
+	Docstrings     bool `json:"docstrings"`
+	ExtraSpacing   bool `json:"extraSpacing"`
+
+	Frozen bool `json:"frozen"`
+}
+
+type PythonMarshmallowOptions struct {
+	Comments     bool `json:"comments"`
+	Docstrings   bool `json:"docstrings"`
+	ExtraSpacing bool `json:"extraSpacing"`
+}
+
 type MyBatisOptions struct {
-	AllCrud bool `json:"allCrud"`
-	Select  bool `json:"select"`
-	Insert  bool `json:"insert"`
-	Update  bool `json:"update"`
-	Delete  bool `json:"delete"`
+	Package string `json:"package"`
+	Lombok  bool   `json:"lombok"`
+	AllCrud bool   `json:"allCrud"`
+	Select  bool   `json:"select"`
+	Insert  bool   `json:"insert"`
+	Update  bool   `json:"update"`
+	Delete  bool   `json:"delete"`
+
+	SelectByPrimaryKey bool `json:"selectByPrimaryKey"`
+	SelectByExample    bool `json:"selectByExample"`
+	Count              bool `json:"count"`
+	Pagination         bool `json:"pagination"`
+	UpdateSelective    bool `json:"updateSelective"`
+	BatchInsert        bool `json:"batchInsert"`
+	DeleteByIds        bool `json:"deleteByIds"`
+
+	Upsert         bool   `json:"upsert"`
+	UpsertKey      string `json:"upsertKey"`
+	OptimisticLock bool   `json:"optimisticLock"`
+}
+
+type SpringJdbcOptions struct {
+	Package string `json:"package"`
+	Lombok  bool   `json:"lombok"`
+	AllCrud bool   `json:"allCrud"`
+	Select  bool   `json:"select"`
+	Insert  bool   `json:"insert"`
+	Update  bool   `json:"update"`
+	Delete  bool   `json:"delete"`
+}
+
+type GoRepositoryOptions struct {
+	Package string `json:"package"`
+	Driver  string `json:"driver"`
+	AllCrud bool   `json:"allCrud"`
+	Select  bool   `json:"select"`
+	Insert  bool   `json:"insert"`
+	Update  bool   `json:"update"`
+	Delete  bool   `json:"delete"`
+}
+
+type DapperOptions struct {
+	Namespace string `json:"namespace"`
+	AllCrud   bool   `json:"allCrud"`
+	Select    bool   `json:"select"`
+	Insert    bool   `json:"insert"`
+	Update    bool   `json:"update"`
+	Delete    bool   `json:"delete"`
 }
 
 type TypeScriptOptions struct {
@@ -198,6 +319,53 @@ type ZodOptions struct {
 	Trim           bool `json:"trim,omitempty"`
 }
 
+type NestDtoOptions struct {
+	Swagger            bool `json:"swagger,omitempty"`
+	DateObjects        bool `json:"dateObjects,omitempty"`
+	OptionalProperties bool `json:"optionalProperties,omitempty"`
+	ReadonlyProperties bool `json:"readonlyProperties,omitempty"`
+}
+
+type ScalaOptions struct {
+	Scala3        bool `json:"scala3,omitempty"`
+	CirceCodec    bool `json:"circeCodec,omitempty"`
+	SnakeCaseKeys bool `json:"snakeCaseKeys,omitempty"`
+	SlickTable    bool `json:"slickTable,omitempty"`
+	Comments      bool `json:"comments,omitempty"`
+	ExtraSpacing  bool `json:"extraSpacing,omitempty"`
+}
+
+type GraphQLOptions struct {
+	Descriptions bool `json:"descriptions,omitempty"`
+	ExtraSpacing bool `json:"extraSpacing,omitempty"`
+}
+
+type ProtobufOptions struct {
+	Package      string `json:"package,omitempty"`
+	GoPackage    string `json:"goPackage,omitempty"`
+	JavaPackage  string `json:"javaPackage,omitempty"`
+	Wrappers     bool   `json:"wrappers,omitempty"`
+	Comments     bool   `json:"comments,omitempty"`
+	ExtraSpacing bool   `json:"extraSpacing,omitempty"`
+}
+
+type JsonSchemaOptions struct {
+	Format       string `json:"format,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Descriptions bool   `json:"descriptions,omitempty"`
+	Strict       bool   `json:"strict,omitempty"`
+}
+
+type AvroOptions struct {
+	Namespace string `json:"namespace,omitempty"`
+	Docs      bool   `json:"docs,omitempty"`
+}
+
+type PrismaOptions struct {
+	Comments bool `json:"comments,omitempty"`
+}
+
 type DatabaseConnectionHealth struct {
 	ConnectionID  int        `json:"connectionId"`
 	Name          string     `json:"name"`
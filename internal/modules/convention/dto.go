@@ -0,0 +1,22 @@
+package convention
+
+type AuditConventionRequest struct {
+	Name            string `json:"name"`
+	CreatedAtColumn string `json:"createdAtColumn"`
+	CreatedAtValue  string `json:"createdAtValue"`
+	CreatedByColumn string `json:"createdByColumn"`
+	CreatedByValue  string `json:"createdByValue"`
+	CreatedIpColumn string `json:"createdIpColumn"`
+	CreatedIpValue  string `json:"createdIpValue"`
+	UpdatedAtColumn string `json:"updatedAtColumn"`
+	UpdatedAtValue  string `json:"updatedAtValue"`
+	UpdatedByColumn string `json:"updatedByColumn"`
+	UpdatedByValue  string `json:"updatedByValue"`
+	UpdatedIpColumn string `json:"updatedIpColumn"`
+	UpdatedIpValue  string `json:"updatedIpValue"`
+	DeletedColumn   string `json:"deletedColumn"`
+	DeletedValue    string `json:"deletedValue"`
+	NotDeletedValue string `json:"notDeletedValue"`
+	VersionColumn   string `json:"versionColumn"`
+	IgnoredPrefixes string `json:"ignoredPrefixes"`
+}
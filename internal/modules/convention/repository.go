@@ -0,0 +1,82 @@
+package convention
+
+import (
+	"context"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	Create(ctx context.Context, convention *domain.AuditConvention) error
+	FindByID(ctx context.Context, id uint) (*domain.AuditConvention, error)
+	FindAll(ctx context.Context, offset, limit int) ([]*domain.AuditConvention, int64, error)
+	Update(ctx context.Context, convention *domain.AuditConvention) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, convention *domain.AuditConvention) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.AuditConvention{}).
+		Where("name = ?", convention.Name).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrConflict
+	}
+	return r.db.WithContext(ctx).Create(convention).Error
+}
+
+func (r *repository) FindByID(ctx context.Context, id uint) (*domain.AuditConvention, error) {
+	var convention domain.AuditConvention
+	if err := r.db.WithContext(ctx).First(&convention, id).Error; err != nil {
+		return nil, err
+	}
+	return &convention, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, offset, limit int) ([]*domain.AuditConvention, int64, error) {
+	var conventions []*domain.AuditConvention
+	var total int64
+
+	if err := r.db.WithContext(ctx).Model(&domain.AuditConvention{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.WithContext(ctx).
+		Offset(offset).
+		Limit(limit).
+		Order("created_at DESC").
+		Find(&conventions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return conventions, total, nil
+}
+
+func (r *repository) Update(ctx context.Context, convention *domain.AuditConvention) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.AuditConvention{}).
+		Where("name = ? AND convention_id <> ?", convention.Name, convention.ConventionID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrConflict
+	}
+	return r.db.WithContext(ctx).Save(convention).Error
+}
+
+func (r *repository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&domain.AuditConvention{}, id).Error
+}
@@ -0,0 +1,106 @@
+package convention
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func newTestRepository(t *testing.T) Repository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	// Every connection to :memory: is a database of its own.
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	if err := db.AutoMigrate(&domain.AuditConvention{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return NewRepository(db)
+}
+
+func TestRepositoryCreateRejectsDuplicateName(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &domain.AuditConvention{Name: "standard"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	err := repo.Create(ctx, &domain.AuditConvention{Name: "standard"})
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("Create duplicate: got %v, want ErrConflict", err)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	first := &domain.AuditConvention{Name: "first"}
+	second := &domain.AuditConvention{Name: "second"}
+	for _, c := range []*domain.AuditConvention{first, second} {
+		if err := repo.Create(ctx, c); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+
+	// Saving a convention under its own name is not a conflict.
+	first.DeletedColumn = "deleted_at"
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	stored, err := repo.FindByID(ctx, uint(first.ConventionID))
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if stored.DeletedColumn != "deleted_at" {
+		t.Errorf("DeletedColumn = %q, want %q", stored.DeletedColumn, "deleted_at")
+	}
+
+	second.Name = "first"
+	if err := repo.Update(ctx, second); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("Update to a taken name: got %v, want ErrConflict", err)
+	}
+}
+
+func TestRepositoryFindAllAndDelete(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c"} {
+		if err := repo.Create(ctx, &domain.AuditConvention{Name: name}); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+
+	page, total, err := repo.FindAll(ctx, 0, 2)
+	if err != nil {
+		t.Fatalf("FindAll: %v", err)
+	}
+	if total != 3 || len(page) != 2 {
+		t.Fatalf("FindAll: got %d of %d, want 2 of 3", len(page), total)
+	}
+
+	id := uint(page[0].ConventionID)
+	if err := repo.Delete(ctx, id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.FindByID(ctx, id); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByID after Delete: got %v, want ErrRecordNotFound", err)
+	}
+}
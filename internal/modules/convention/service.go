@@ -0,0 +1,147 @@
+package convention
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+var (
+	// columnName matches the plain column names a convention may name.
+	columnName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+	// sqlValue matches the SQL a value may be written as: a keyword or a
+	// call without arguments such as NOW(), a number, or a quoted string.
+	sqlValue = regexp.MustCompile(`^(?:[A-Za-z_][A-Za-z0-9_]*(?:\(\))?|-?[0-9]+(?:\.[0-9]+)?|'[^']*')$`)
+)
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Create(ctx context.Context, req *AuditConventionRequest) (*domain.AuditConvention, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	convention := &domain.AuditConvention{}
+	apply(convention, req)
+
+	if err := s.repo.Create(ctx, convention); err != nil {
+		return nil, err
+	}
+
+	return convention, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, id uint) (*domain.AuditConvention, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context, page, pageSize int) ([]*domain.AuditConvention, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.repo.FindAll(ctx, offset, pageSize)
+}
+
+// Update replaces the whole profile, since clearing a column is as
+// meaningful as setting one.
+func (s *Service) Update(ctx context.Context, id uint, req *AuditConventionRequest) (*domain.AuditConvention, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	convention, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apply(convention, req)
+
+	if err := s.repo.Update(ctx, convention); err != nil {
+		return nil, err
+	}
+
+	return convention, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// validate rejects a convention whose name is missing or whose columns and
+// values could not be written into a statement as they are.
+func validate(req *AuditConventionRequest) error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrBadRequest)
+	}
+	if len(req.Name) > 100 {
+		return fmt.Errorf("%w: name must be at most 100 characters", domain.ErrBadRequest)
+	}
+
+	columns := [][2]string{
+		{"createdAtColumn", req.CreatedAtColumn},
+		{"createdByColumn", req.CreatedByColumn},
+		{"createdIpColumn", req.CreatedIpColumn},
+		{"updatedAtColumn", req.UpdatedAtColumn},
+		{"updatedByColumn", req.UpdatedByColumn},
+		{"updatedIpColumn", req.UpdatedIpColumn},
+		{"deletedColumn", req.DeletedColumn},
+		{"versionColumn", req.VersionColumn},
+	}
+	for _, c := range columns {
+		if c[1] != "" && !columnName.MatchString(c[1]) {
+			return fmt.Errorf("%w: %s must be a column name", domain.ErrBadRequest, c[0])
+		}
+	}
+
+	values := [][2]string{
+		{"createdAtValue", req.CreatedAtValue},
+		{"createdByValue", req.CreatedByValue},
+		{"createdIpValue", req.CreatedIpValue},
+		{"updatedAtValue", req.UpdatedAtValue},
+		{"updatedByValue", req.UpdatedByValue},
+		{"updatedIpValue", req.UpdatedIpValue},
+		{"deletedValue", req.DeletedValue},
+		{"notDeletedValue", req.NotDeletedValue},
+	}
+	for _, v := range values {
+		value := strings.TrimSpace(v[1])
+		switch strings.ToLower(value) {
+		case "", "param", "now":
+			continue
+		}
+		if !sqlValue.MatchString(value) {
+			return fmt.Errorf("%w: %s must be param, now, a function call such as NOW(), a number or a quoted string", domain.ErrBadRequest, v[0])
+		}
+	}
+
+	return nil
+}
+
+func apply(convention *domain.AuditConvention, req *AuditConventionRequest) {
+	convention.Name = req.Name
+	convention.CreatedAtColumn = req.CreatedAtColumn
+	convention.CreatedAtValue = req.CreatedAtValue
+	convention.CreatedByColumn = req.CreatedByColumn
+	convention.CreatedByValue = req.CreatedByValue
+	convention.CreatedIpColumn = req.CreatedIpColumn
+	convention.CreatedIpValue = req.CreatedIpValue
+	convention.UpdatedAtColumn = req.UpdatedAtColumn
+	convention.UpdatedAtValue = req.UpdatedAtValue
+	convention.UpdatedByColumn = req.UpdatedByColumn
+	convention.UpdatedByValue = req.UpdatedByValue
+	convention.UpdatedIpColumn = req.UpdatedIpColumn
+	convention.UpdatedIpValue = req.UpdatedIpValue
+	convention.DeletedColumn = req.DeletedColumn
+	convention.DeletedValue = req.DeletedValue
+	convention.NotDeletedValue = req.NotDeletedValue
+	convention.VersionColumn = req.VersionColumn
+	convention.IgnoredPrefixes = req.IgnoredPrefixes
+}
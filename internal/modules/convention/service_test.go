@@ -0,0 +1,100 @@
+package convention
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	"gorm.io/gorm"
+)
+
+func TestServiceCreate(t *testing.T) {
+	service := NewService(newTestRepository(t))
+	ctx := context.Background()
+
+	convention, err := service.Create(ctx, &AuditConventionRequest{
+		Name:            "  standard  ",
+		CreatedAtColumn: "created_at",
+		CreatedAtValue:  "now",
+		CreatedByColumn: "created_by",
+		CreatedByValue:  "param",
+		DeletedColumn:   "is_deleted",
+		DeletedValue:    "1",
+		NotDeletedValue: "0",
+		UpdatedAtColumn: "updated_at",
+		UpdatedAtValue:  "CURRENT_TIMESTAMP",
+		UpdatedIpColumn: "updated_ip",
+		UpdatedIpValue:  "'0.0.0.0'",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if convention.Name != "standard" {
+		t.Errorf("Name = %q, want %q", convention.Name, "standard")
+	}
+
+	_, err = service.Create(ctx, &AuditConventionRequest{Name: "standard"})
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("Create duplicate: got %v, want ErrConflict", err)
+	}
+}
+
+func TestServiceRejectsInvalidRequests(t *testing.T) {
+	service := NewService(newTestRepository(t))
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  AuditConventionRequest
+	}{
+		{"missing name", AuditConventionRequest{Name: "  "}},
+		{"long name", AuditConventionRequest{Name: strings.Repeat("n", 101)}},
+		{"column with spaces", AuditConventionRequest{Name: "x", CreatedAtColumn: "created at"}},
+		{"column with a statement", AuditConventionRequest{Name: "x", VersionColumn: "v; DROP TABLE t"}},
+		{"value with a statement", AuditConventionRequest{Name: "x", UpdatedAtValue: "NOW(); DROP TABLE t"}},
+		{"value with a subquery", AuditConventionRequest{Name: "x", DeletedValue: "(SELECT 1)"}},
+		{"unterminated string", AuditConventionRequest{Name: "x", NotDeletedValue: "'N"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.Create(ctx, &tt.req); !errors.Is(err, domain.ErrBadRequest) {
+				t.Fatalf("Create: got %v, want ErrBadRequest", err)
+			}
+		})
+	}
+}
+
+func TestServiceUpdate(t *testing.T) {
+	service := NewService(newTestRepository(t))
+	ctx := context.Background()
+
+	first, err := service.Create(ctx, &AuditConventionRequest{Name: "first", VersionColumn: "version"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := service.Create(ctx, &AuditConventionRequest{Name: "second"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	id := uint(first.ConventionID)
+
+	// Update replaces the whole profile.
+	updated, err := service.Update(ctx, id, &AuditConventionRequest{Name: "first", DeletedColumn: "deleted_at"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.VersionColumn != "" || updated.DeletedColumn != "deleted_at" {
+		t.Errorf("Update kept %+v", updated)
+	}
+
+	if _, err := service.Update(ctx, id, &AuditConventionRequest{Name: "second"}); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("Update to a taken name: got %v, want ErrConflict", err)
+	}
+	if _, err := service.Update(ctx, id, &AuditConventionRequest{Name: ""}); !errors.Is(err, domain.ErrBadRequest) {
+		t.Errorf("Update without a name: got %v, want ErrBadRequest", err)
+	}
+	if _, err := service.Update(ctx, id+100, &AuditConventionRequest{Name: "third"}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Update unknown id: got %v, want ErrRecordNotFound", err)
+	}
+}
@@ -0,0 +1,278 @@
+package avro
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Record writes an Avro schema. A single table is a record schema; several
+// tables are a union of their named records, so the bundle stays one valid
+// .avsc document and is rendered by Footer.
+type Record struct {
+	records []record
+}
+
+type record struct {
+	Type      string  `json:"type"`
+	Name      string  `json:"name"`
+	Namespace string  `json:"namespace,omitempty"`
+	Fields    []field `json:"fields"`
+}
+
+type field struct {
+	Name    string          `json:"name"`
+	Type    any             `json:"type"`
+	Doc     string          `json:"doc,omitempty"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+type logicalType struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+	Precision   int    `json:"precision,omitempty"`
+	Scale       int    `json:"scale,omitempty"`
+}
+
+type enumType struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Symbols []string `json:"symbols"`
+}
+
+type arrayType struct {
+	Type  string `json:"type"`
+	Items any    `json:"items"`
+}
+
+var avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func (r *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	recordName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.AvroOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Avro Options", fmt.Errorf("invalid Avro options: %w", err)
+	}
+
+	schema := record{
+		Type:      "record",
+		Name:      recordName,
+		Namespace: opt.Namespace,
+		Fields:    []field{},
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	for _, col := range columns {
+		var avroType any
+		switch strings.ToLower(dbType) {
+		case "mysql":
+			avroType = mapMySQLToAvroType(col)
+		case "postgres":
+			avroType = mapPostgresToAvroType(col)
+		case "mssql":
+			avroType = mapMSSQLToAvroType(col)
+		default:
+			avroType = "string"
+		}
+
+		if symbols := common.EnumValues(col); len(symbols) > 0 && validSymbols(symbols) {
+			avroType = enumType{
+				Type:    "enum",
+				Name:    recordName + common.ToPascalCase(col.ColumnName),
+				Symbols: symbols,
+			}
+		}
+
+		f := field{
+			Name: invalidNameChars.ReplaceAllString(col.ColumnName, "_"),
+			Type: avroType,
+		}
+
+		// Nullable columns become a union with null first so the null default is valid.
+		if strings.EqualFold(col.IsNullable, "YES") {
+			f.Type = []any{"null", avroType}
+			f.Default = json.RawMessage("null")
+		}
+
+		if opt.Docs && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			f.Doc = strings.TrimSpace(col.ColumnComment.String)
+		}
+
+		schema.Fields = append(schema.Fields, f)
+	}
+
+	r.records = append(r.records, schema)
+	return "", nil
+}
+
+func (r *Record) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var schema any = r.records
+	if len(r.records) == 1 {
+		schema = r.records[0]
+	}
+
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out) + "\n", nil
+}
+
+func validSymbols(symbols []string) bool {
+	for _, s := range symbols {
+		if !avroName.MatchString(s) {
+			return false
+		}
+	}
+	return true
+}
+
+func decimalType(col domain.SqlData) any {
+	if !col.NumericPrecision.Valid || col.NumericPrecision.Int16 <= 0 {
+		return "string"
+	}
+	return logicalType{
+		Type:        "bytes",
+		LogicalType: "decimal",
+		Precision:   int(col.NumericPrecision.Int16),
+		Scale:       int(col.NumericScale.Int16),
+	}
+}
+
+func mapMySQLToAvroType(col domain.SqlData) any {
+	switch strings.ToLower(col.DataType) {
+
+	case "int", "integer", "mediumint", "smallint", "tinyint", "year":
+		return "int"
+	case "bigint":
+		return "long"
+
+	case "decimal", "numeric":
+		return decimalType(col)
+	case "float":
+		return "float"
+	case "double":
+		return "double"
+
+	case "varchar", "char", "text", "longtext", "mediumtext", "tinytext", "enum", "set", "json":
+		return "string"
+
+	case "date":
+		return logicalType{Type: "int", LogicalType: "date"}
+	case "datetime", "timestamp":
+		return logicalType{Type: "long", LogicalType: "timestamp-micros"}
+	case "time":
+		return logicalType{Type: "long", LogicalType: "time-micros"}
+
+	case "boolean", "bit":
+		return "boolean"
+
+	case "blob", "longblob", "mediumblob", "tinyblob", "binary", "varbinary":
+		return "bytes"
+
+	default:
+		return "string"
+	}
+}
+
+func mapPostgresToAvroType(col domain.SqlData) any {
+	switch strings.ToLower(col.DataType) {
+
+	case "smallint", "int2", "integer", "int", "int4", "serial":
+		return "int"
+	case "bigint", "int8", "bigserial":
+		return "long"
+
+	case "decimal", "numeric":
+		return decimalType(col)
+	case "money":
+		return "string"
+	case "real", "float4":
+		return "float"
+	case "double precision", "float8":
+		return "double"
+
+	case "varchar", "character varying", "char", "character", "bpchar", "text", "citext",
+		"json", "jsonb", "xml", "inet", "cidr", "macaddr":
+		return "string"
+
+	case "uuid":
+		return logicalType{Type: "string", LogicalType: "uuid"}
+
+	case "date":
+		return logicalType{Type: "int", LogicalType: "date"}
+	case "time", "time without time zone":
+		return logicalType{Type: "long", LogicalType: "time-micros"}
+	case "timestamp", "timestamp without time zone", "timestamptz", "timestamp with time zone":
+		return logicalType{Type: "long", LogicalType: "timestamp-micros"}
+
+	case "boolean", "bool":
+		return "boolean"
+
+	case "bytea":
+		return "bytes"
+
+	case "_int4", "integer[]":
+		return arrayType{Type: "array", Items: "int"}
+	case "_int8", "bigint[]":
+		return arrayType{Type: "array", Items: "long"}
+	case "_text", "text[]":
+		return arrayType{Type: "array", Items: "string"}
+	case "_uuid", "uuid[]":
+		return arrayType{Type: "array", Items: logicalType{Type: "string", LogicalType: "uuid"}}
+
+	default:
+		return "string"
+	}
+}
+
+func mapMSSQLToAvroType(col domain.SqlData) any {
+	switch strings.ToLower(col.DataType) {
+
+	case "int", "smallint", "tinyint":
+		return "int"
+	case "bigint":
+		return "long"
+
+	case "decimal", "numeric", "money", "smallmoney":
+		return decimalType(col)
+	case "real":
+		return "float"
+	case "float":
+		return "double"
+
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext", "xml":
+		return "string"
+
+	case "uniqueidentifier":
+		return logicalType{Type: "string", LogicalType: "uuid"}
+
+	case "date":
+		return logicalType{Type: "int", LogicalType: "date"}
+	case "time":
+		return logicalType{Type: "long", LogicalType: "time-micros"}
+	case "datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return logicalType{Type: "long", LogicalType: "timestamp-micros"}
+
+	case "bit":
+		return "boolean"
+
+	case "binary", "varbinary", "image", "rowversion", "timestamp":
+		return "bytes"
+
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,74 @@
+package csharp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// dataAnnotations collects the System.ComponentModel.DataAnnotations
+// attributes for DTO properties and the usings they need.
+type dataAnnotations struct {
+	usings []string
+}
+
+func (a *dataAnnotations) use(namespace, attribute string) string {
+	a.usingNamespace(namespace)
+	return attribute
+}
+
+// usingNamespace adds a using directive on its own, for attributes the
+// generators write themselves such as [JsonPropertyName].
+func (a *dataAnnotations) usingNamespace(namespace string) {
+	if !slices.Contains(a.usings, namespace) {
+		a.usings = append(a.usings, namespace)
+	}
+}
+
+// attributes derives the attributes for a column. They follow the same rules
+// as the EF Core configuration, so either can describe the table.
+func (a *dataAnnotations) attributes(dbType string, col domain.SqlData, propName string) []string {
+	var out []string
+
+	if common.IsPrimaryKey(col) {
+		out = append(out, a.use("System.ComponentModel.DataAnnotations", "Key"))
+	}
+
+	if propName != col.ColumnName {
+		out = append(out, a.use("System.ComponentModel.DataAnnotations.Schema",
+			fmt.Sprintf("Column(\"%s\")", col.ColumnName)))
+	}
+
+	// Value types are required unless nullable; reference types need saying so.
+	if !strings.EqualFold(col.IsNullable, "YES") && !IsValueType(FieldType(dbType, col)) {
+		out = append(out, a.use("System.ComponentModel.DataAnnotations", "Required"))
+	}
+
+	dataType := strings.ToLower(col.DataType)
+	if hasLength(dataType) && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		out = append(out, a.use("System.ComponentModel.DataAnnotations",
+			fmt.Sprintf("MaxLength(%d)", col.CharacterMaximumLength.Int16)))
+	}
+
+	if slices.Contains([]string{"decimal", "numeric"}, dataType) && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+		out = append(out, a.use("Microsoft.EntityFrameworkCore",
+			fmt.Sprintf("Precision(%d, %d)", col.NumericPrecision.Int16, col.NumericScale.Int16)))
+	}
+
+	return out
+}
+
+// write emits the collected usings ahead of the type declaration.
+func (a *dataAnnotations) write(sb *strings.Builder) {
+	if len(a.usings) == 0 {
+		return
+	}
+	slices.Sort(a.usings)
+	for _, u := range a.usings {
+		sb.WriteString(fmt.Sprintf("using %s;\n", u))
+	}
+	sb.WriteString("\n")
+}
@@ -14,6 +14,7 @@ type Dto struct{}
 
 func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
 	var sb strings.Builder
+	var annotations dataAnnotations
 
 	tableName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
 
@@ -24,57 +25,48 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 
 	sb.WriteString(fmt.Sprintf("public class %s\n{\n", tableName))
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		if err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		); err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	for _, col := range columns {
 		var cSharpType string
 
 		switch strings.ToLower(dbType) {
 		case "mysql":
-			cSharpType = mapMySQLToCSharp(dataType)
+			cSharpType = mapMySQLToCSharp(col.DataType)
 		case "postgres":
-			cSharpType = mapPostgresToCSharp(dataType)
+			cSharpType = mapPostgresToCSharp(col.DataType)
 		case "mssql":
-			cSharpType = mapMSSQLToCSharp(dataType)
+			cSharpType = mapMSSQLToCSharp(col.DataType)
 		default:
 			cSharpType = "any"
 		}
 
-		isNull := strings.EqualFold(isNullable, "YES")
+		isNull := strings.EqualFold(col.IsNullable, "YES")
 
-		if opt.Nullable && isNull && isValueType(cSharpType) {
+		if opt.Nullable && isNull && IsValueType(cSharpType) {
 			cSharpType += "?"
-		} else if opt.Nullable && isNull && !isValueType(cSharpType) {
+		} else if opt.Nullable && isNull && !IsValueType(cSharpType) {
 			cSharpType += "?"
 		}
 
-		fieldName := common.ToPascalCase(columnName)
+		fieldName := common.ToPascalCase(col.ColumnName)
 		if opt.CamelCaseProperties {
-			fieldName = common.ToCamelCase(columnName)
+			fieldName = common.ToCamelCase(col.ColumnName)
+		}
+
+		if opt.DataAnnotations {
+			for _, a := range annotations.attributes(dbType, col, fieldName) {
+				sb.WriteString(fmt.Sprintf("    [%s]\n", a))
+			}
 		}
 
 		if opt.JsonPropertyName {
 			sb.WriteString(fmt.Sprintf(
 				"    [JsonPropertyName(\"%s\")]\n",
-				columnName,
+				col.ColumnName,
 			))
 		}
 
@@ -106,12 +98,17 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 		return "", err
 	}
 
-	return sb.String(), nil
+	var out strings.Builder
+	annotations.write(&out)
+	out.WriteString(sb.String())
+	return out.String(), nil
 }
 
-func isValueType(csharpType string) bool {
+// IsValueType reports whether a CLR type is a value type, which needs a ? to
+// hold null.
+func IsValueType(csharpType string) bool {
 	switch csharpType {
-	case "int", "long", "float", "double", "decimal", "bool", "DateTime", "Guid":
+	case "byte", "short", "int", "long", "float", "double", "decimal", "bool", "DateTime", "DateTimeOffset", "TimeSpan", "Guid":
 		return true
 	default:
 		return false
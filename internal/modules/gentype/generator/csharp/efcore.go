@@ -0,0 +1,412 @@
+package csharp
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// EfCore writes Entity Framework Core entities with a fluent
+// IEntityTypeConfiguration per table and a DbContext exposing all of them.
+// Navigations need both ends of a foreign key, so tables are collected by
+// Generate and rendered by Footer.
+type EfCore struct {
+	opt      domain.EfCoreOptions
+	entities []*efEntity
+}
+
+type efEntity struct {
+	ClassName string
+	SetName   string
+	Table     string
+	Columns   []domain.SqlData
+	Keys      []domain.SqlData
+	Relations []efRelation
+	Inverse   []efRelation
+	dbType    string
+	members   []string
+}
+
+type efRelation struct {
+	Navigation string
+	Inverse    string
+	Column     string
+	RefCol     string
+	Source     *efEntity
+	Target     *efEntity
+	Nullable   bool
+}
+
+// inferredColumnTypes are the store types each provider already maps the
+// property's CLR type to; any other type is configured with HasColumnType.
+var inferredColumnTypes = map[string][]string{
+	"mssql": {
+		"nvarchar", "int", "bigint", "smallint", "tinyint", "bit", "decimal", "float", "real",
+		"datetime2", "datetimeoffset", "time", "uniqueidentifier", "varbinary",
+	},
+	"postgres": {
+		"text", "character varying", "varchar", "integer", "int4", "bigint", "int8", "smallint", "int2",
+		"boolean", "bool", "numeric", "decimal", "real", "float4", "double precision", "float8",
+		"timestamp with time zone", "uuid", "bytea",
+	},
+	"mysql": {
+		"varchar", "longtext", "int", "bigint", "smallint", "decimal", "float", "double",
+		"varbinary", "longblob",
+	},
+}
+
+var efProviders = map[string]string{
+	"mssql":    "UseSqlServer (Microsoft.EntityFrameworkCore.SqlServer)",
+	"postgres": "UseNpgsql (Npgsql.EntityFrameworkCore.PostgreSQL)",
+	"mysql":    "UseMySql (Pomelo.EntityFrameworkCore.MySql)",
+}
+
+func (e *EfCore) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	if err := json.Unmarshal(req.Options, &e.opt); err != nil {
+		return "Invalid EF Core Options", fmt.Errorf("invalid EF Core options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	e.entities = append(e.entities, &efEntity{
+		ClassName: req.Prefix + common.ToPascalCase(tbN) + req.Suffix,
+		SetName:   common.ToPascalCase(tbN),
+		Table:     tbN,
+		Columns:   columns,
+		Keys:      common.PrimaryKeys(columns),
+		dbType:    strings.ToLower(dbType),
+	})
+
+	return "", nil
+}
+
+func (e *EfCore) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	e.resolveRelations()
+
+	var sb strings.Builder
+
+	sb.WriteString("using System;\n")
+	sb.WriteString("using System.Collections.Generic;\n")
+	sb.WriteString("using Microsoft.EntityFrameworkCore;\n")
+	sb.WriteString("using Microsoft.EntityFrameworkCore.Metadata.Builders;\n")
+
+	if e.opt.Namespace != "" {
+		sb.WriteString(fmt.Sprintf("\nnamespace %s;\n", e.opt.Namespace))
+	}
+
+	for _, en := range e.entities {
+		sb.WriteString("\n")
+		e.writeEntity(&sb, en)
+		sb.WriteString("\n")
+		e.writeConfiguration(&sb, en)
+	}
+
+	sb.WriteString("\n")
+	e.writeContext(&sb, dbType)
+
+	return sb.String(), nil
+}
+
+func (e *EfCore) findEntity(table string) *efEntity {
+	for _, en := range e.entities {
+		if strings.EqualFold(en.Table, table) {
+			return en
+		}
+	}
+	return nil
+}
+
+// resolveRelations turns foreign keys to bundled tables into reference
+// navigations and records the matching collection on the referenced entity.
+func (e *EfCore) resolveRelations() {
+	for _, en := range e.entities {
+		for _, col := range en.Columns {
+			en.members = append(en.members, common.ToPascalCase(col.ColumnName))
+		}
+	}
+
+	for _, en := range e.entities {
+		for _, col := range en.Columns {
+			if !col.ReferencedTable.Valid {
+				continue
+			}
+			target := e.findEntity(col.ReferencedTable.String)
+			if target == nil {
+				continue
+			}
+
+			var navigation string
+			if name := strings.TrimSuffix(common.ToPascalCase(col.ColumnName), "Id"); name != common.ToPascalCase(col.ColumnName) {
+				navigation = en.member(name, target.ClassName, target.ClassName+"Navigation")
+			} else {
+				navigation = en.member(target.ClassName, target.ClassName+"Navigation")
+			}
+
+			en.Relations = append(en.Relations, efRelation{
+				Navigation: navigation,
+				Column:     col.ColumnName,
+				RefCol:     col.ReferencedColumn.String,
+				Source:     en,
+				Target:     target,
+				Nullable:   strings.EqualFold(col.IsNullable, "YES"),
+			})
+		}
+	}
+
+	for _, en := range e.entities {
+		for i, r := range en.Relations {
+			inverse := en.SetName
+			for _, other := range en.Relations {
+				if other.Target == r.Target && other.Navigation != r.Navigation {
+					inverse += r.Navigation
+					break
+				}
+			}
+			// EF Core's scaffolder falls back to Inverse<Navigation> too.
+			en.Relations[i].Inverse = r.Target.member(inverse, "Inverse"+r.Navigation)
+			r.Target.Inverse = append(r.Target.Inverse, en.Relations[i])
+		}
+	}
+}
+
+// member claims the first candidate that is neither the class name nor
+// another member of the entity, numbering the last one when all are taken.
+func (en *efEntity) member(candidates ...string) string {
+	free := func(name string) bool {
+		return name != "" && name != en.ClassName && !slices.Contains(en.members, name)
+	}
+	name := candidates[len(candidates)-1]
+	if i := slices.IndexFunc(candidates, free); i >= 0 {
+		name = candidates[i]
+	} else {
+		for n := 2; !free(name); n++ {
+			name = fmt.Sprintf("%s%d", candidates[len(candidates)-1], n)
+		}
+	}
+	en.members = append(en.members, name)
+	return name
+}
+
+func (e *EfCore) writeEntity(sb *strings.Builder, en *efEntity) {
+	sb.WriteString(fmt.Sprintf("public partial class %s\n{\n", en.ClassName))
+
+	first := true
+	separate := func() {
+		if !first && e.opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+		first = false
+	}
+
+	for _, col := range en.Columns {
+		separate()
+
+		propertyType := FieldType(en.dbType, col)
+		initializer := ""
+		if strings.EqualFold(col.IsNullable, "YES") {
+			propertyType = makeNullableCSharpType(propertyType)
+		} else if !IsValueType(propertyType) {
+			// Required reference types are set by EF Core when the entity is materialized.
+			initializer = " = null!;"
+		}
+
+		sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }%s\n", propertyType, common.ToPascalCase(col.ColumnName), initializer))
+	}
+
+	for _, r := range en.Relations {
+		separate()
+		if r.Nullable {
+			sb.WriteString(fmt.Sprintf("    public virtual %s? %s { get; set; }\n", r.Target.ClassName, r.Navigation))
+		} else {
+			sb.WriteString(fmt.Sprintf("    public virtual %s %s { get; set; } = null!;\n", r.Target.ClassName, r.Navigation))
+		}
+	}
+
+	for _, r := range en.Inverse {
+		separate()
+		sb.WriteString(fmt.Sprintf("    public virtual ICollection<%s> %s { get; set; } = new List<%s>();\n",
+			r.Source.ClassName, r.Inverse, r.Source.ClassName))
+	}
+
+	sb.WriteString("}\n")
+}
+
+func (e *EfCore) writeConfiguration(sb *strings.Builder, en *efEntity) {
+	sb.WriteString(fmt.Sprintf("public class %sConfiguration : IEntityTypeConfiguration<%s>\n{\n", en.ClassName, en.ClassName))
+	sb.WriteString(fmt.Sprintf("    public void Configure(EntityTypeBuilder<%s> builder)\n    {\n", en.ClassName))
+
+	if e.opt.Schema != "" {
+		sb.WriteString(fmt.Sprintf("        builder.ToTable(\"%s\", \"%s\");\n", en.Table, e.opt.Schema))
+	} else {
+		sb.WriteString(fmt.Sprintf("        builder.ToTable(\"%s\");\n", en.Table))
+	}
+
+	switch len(en.Keys) {
+	case 0:
+		sb.WriteString("        builder.HasNoKey();\n")
+	case 1:
+		sb.WriteString(fmt.Sprintf("        builder.HasKey(e => e.%s);\n", common.ToPascalCase(en.Keys[0].ColumnName)))
+	default:
+		var keys []string
+		for _, key := range en.Keys {
+			keys = append(keys, "e."+common.ToPascalCase(key.ColumnName))
+		}
+		sb.WriteString(fmt.Sprintf("        builder.HasKey(e => new { %s });\n", strings.Join(keys, ", ")))
+	}
+
+	for _, col := range en.Columns {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("        builder.Property(e => e.%s)\n", common.ToPascalCase(col.ColumnName)))
+		calls := propertyCalls(en, col)
+		for i, call := range calls {
+			sb.WriteString("            ." + call)
+			if i == len(calls)-1 {
+				sb.WriteString(";")
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	for _, col := range en.Columns {
+		if strings.Contains(col.ColumnKey, "UNI") {
+			sb.WriteString("\n")
+			sb.WriteString(fmt.Sprintf("        builder.HasIndex(e => e.%s).IsUnique();\n", common.ToPascalCase(col.ColumnName)))
+		}
+	}
+
+	for _, r := range en.Relations {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("        builder.HasOne(d => d.%s)\n", r.Navigation))
+		sb.WriteString(fmt.Sprintf("            .WithMany(p => p.%s)\n", r.Inverse))
+		sb.WriteString(fmt.Sprintf("            .HasForeignKey(d => d.%s)", common.ToPascalCase(r.Column)))
+		// Foreign keys to a unique column rather than the primary key name it.
+		if len(r.Target.Keys) != 1 || !strings.EqualFold(r.Target.Keys[0].ColumnName, r.RefCol) {
+			sb.WriteString(fmt.Sprintf("\n            .HasPrincipalKey(p => p.%s)", common.ToPascalCase(r.RefCol)))
+		}
+		sb.WriteString(";\n")
+	}
+
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+}
+
+// propertyCalls lists the fluent calls configuring a column's property.
+func propertyCalls(en *efEntity, col domain.SqlData) []string {
+	calls := []string{fmt.Sprintf("HasColumnName(\"%s\")", col.ColumnName)}
+
+	dataType := strings.ToLower(col.DataType)
+	isNull := strings.EqualFold(col.IsNullable, "YES")
+
+	if columnType := storeType(en.dbType, col); columnType != "" {
+		calls = append(calls, fmt.Sprintf("HasColumnType(\"%s\")", columnType))
+	}
+
+	if hasLength(dataType) && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		calls = append(calls, fmt.Sprintf("HasMaxLength(%d)", col.CharacterMaximumLength.Int16))
+	}
+
+	if slices.Contains([]string{"decimal", "numeric"}, dataType) && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+		calls = append(calls, fmt.Sprintf("HasPrecision(%d, %d)", col.NumericPrecision.Int16, col.NumericScale.Int16))
+	}
+
+	// Value types are required unless nullable; reference types need saying so.
+	if !isNull && !IsValueType(FieldType(en.dbType, col)) {
+		calls = append(calls, "IsRequired()")
+	}
+
+	switch {
+	case col.IsGenerated == "YES":
+		calls = append(calls, "ValueGeneratedOnAddOrUpdate()")
+	case col.IsIdentity == "YES":
+		calls = append(calls, "ValueGeneratedOnAdd()")
+	case len(en.Keys) == 1 && common.IsPrimaryKey(col) && IsValueType(FieldType(en.dbType, col)):
+		// EF Core assumes numeric and Guid keys are generated by the database.
+		calls = append(calls, "ValueGeneratedNever()")
+	}
+
+	return calls
+}
+
+// storeType returns the column type to configure, or "" when the provider's
+// default mapping already produces it.
+func storeType(dbType string, col domain.SqlData) string {
+	dataType := strings.ToLower(col.DataType)
+	if slices.Contains(inferredColumnTypes[dbType], dataType) {
+		return ""
+	}
+
+	// MySQL enums only round trip with their labels.
+	if values := common.EnumValues(col); dataType == "enum" && len(values) > 0 {
+		return fmt.Sprintf("enum('%s')", strings.Join(values, "','"))
+	}
+
+	if hasLength(dataType) && col.CharacterMaximumLength.Valid {
+		switch {
+		case col.CharacterMaximumLength.Int16 < 0:
+			return dataType + "(max)"
+		case col.CharacterMaximumLength.Int16 > 0:
+			return fmt.Sprintf("%s(%d)", dataType, col.CharacterMaximumLength.Int16)
+		}
+	}
+
+	return dataType
+}
+
+func hasLength(dataType string) bool {
+	return strings.Contains(dataType, "char") || strings.Contains(dataType, "binary")
+}
+
+// FieldType returns the CLR type of a column, without nullability. Enum
+// columns hold their labels as strings, since EF Core cannot map object.
+func FieldType(dbType string, col domain.SqlData) string {
+	if len(common.EnumValues(col)) > 0 {
+		return "string"
+	}
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return mapMySQLToCSharp(col.DataType)
+	case "postgres", "postgresql":
+		return mapPostgresToCSharp(col.DataType)
+	case "mssql", "sqlserver":
+		return mapMSSQLToCSharp(col.DataType)
+	default:
+		return "object"
+	}
+}
+
+func (e *EfCore) writeContext(sb *strings.Builder, dbType string) {
+	contextName := e.opt.ContextName
+	if contextName == "" {
+		contextName = "AppDbContext"
+	}
+
+	if provider, ok := efProviders[strings.ToLower(dbType)]; ok {
+		sb.WriteString(fmt.Sprintf("// Register with options.%s.\n", provider))
+	}
+	sb.WriteString(fmt.Sprintf("public partial class %s : DbContext\n{\n", contextName))
+	sb.WriteString(fmt.Sprintf("    public %s(DbContextOptions<%s> options)\n", contextName, contextName))
+	sb.WriteString("        : base(options)\n")
+	sb.WriteString("    {\n")
+	sb.WriteString("    }\n\n")
+
+	for _, en := range e.entities {
+		sb.WriteString(fmt.Sprintf("    public virtual DbSet<%s> %s { get; set; }\n", en.ClassName, en.SetName))
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString("    protected override void OnModelCreating(ModelBuilder modelBuilder)\n")
+	sb.WriteString("    {\n")
+	for _, en := range e.entities {
+		sb.WriteString(fmt.Sprintf("        modelBuilder.ApplyConfiguration(new %sConfiguration());\n", en.ClassName))
+	}
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+}
@@ -14,6 +14,7 @@ type Record struct{}
 
 func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
 	var sb strings.Builder
+	var annotations dataAnnotations
 
 	tableName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
 
@@ -27,65 +28,62 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 		DbName     string
 		CSharpType string
 		IsNullable bool
+		Attributes []string
 	}
 
 	var fields []field
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var charMax sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		if err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&charMax,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		); err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	for _, col := range columns {
 		var csharpType string
 		switch strings.ToLower(dbType) {
 		case "mysql":
-			csharpType = mapMySQLToCSharp(dataType)
+			csharpType = mapMySQLToCSharp(col.DataType)
 		case "postgres", "postgresql":
-			csharpType = mapPostgresToCSharp(dataType)
+			csharpType = mapPostgresToCSharp(col.DataType)
 		case "mssql", "sqlserver":
-			csharpType = mapMSSQLToCSharp(dataType)
+			csharpType = mapMSSQLToCSharp(col.DataType)
 		default:
 			csharpType = "object"
 		}
 
-		isNull := strings.EqualFold(isNullable, "YES")
+		isNull := strings.EqualFold(col.IsNullable, "YES")
 
 		if opt.Nullable && isNull {
 			csharpType = makeNullableCSharpType(csharpType)
 		}
 
-		propName := common.ToPascalCase(columnName)
+		propName := common.ToPascalCase(col.ColumnName)
 		if opt.CamelCaseProperties {
-			propName = common.ToCamelCase(columnName)
+			propName = common.ToCamelCase(col.ColumnName)
+		}
+
+		var attributes []string
+		if opt.DataAnnotations {
+			attributes = annotations.attributes(dbType, col, propName)
 		}
 
 		fields = append(fields, field{
 			Name:       propName,
-			DbName:     columnName,
+			DbName:     col.ColumnName,
 			CSharpType: csharpType,
 			IsNullable: isNull,
+			Attributes: attributes,
 		})
 	}
 
 	if opt.Positional {
+		annotations.write(&sb)
 		sb.WriteString(fmt.Sprintf("public record %s(\n", tableName))
 		for i, f := range fields {
+			// Attributes on positional parameters must target the generated property.
+			for _, a := range f.Attributes {
+				sb.WriteString(fmt.Sprintf("    [property: %s]\n", a))
+			}
 			sb.WriteString(fmt.Sprintf("    %s %s", f.CSharpType, f.Name))
 			if i < len(fields)-1 {
 				sb.WriteString(",")
@@ -97,12 +95,17 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 	}
 
 	if opt.JsonPropertyName {
-		sb.WriteString("using System.Text.Json.Serialization;\n\n")
+		annotations.usingNamespace("System.Text.Json.Serialization")
 	}
+	annotations.write(&sb)
 
 	sb.WriteString(fmt.Sprintf("public record %s\n{\n", tableName))
 
 	for _, f := range fields {
+		for _, a := range f.Attributes {
+			sb.WriteString(fmt.Sprintf("    [%s]\n", a))
+		}
+
 		if opt.JsonPropertyName {
 			sb.WriteString(fmt.Sprintf(
 				"    [JsonPropertyName(\"%s\")]\n",
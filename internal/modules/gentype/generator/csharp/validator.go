@@ -0,0 +1,87 @@
+package csharp
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Validator writes a FluentValidation validator for the DTO or record of the
+// same name, with rules from the column's nullability, length and precision.
+type Validator struct{}
+
+func (d *Validator) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+	validatorName := typeName + "Validator"
+
+	var opt domain.CSharpValidatorOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid CSharp Validator Options", fmt.Errorf("invalid CSharp validator options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var rules []string
+	for _, col := range columns {
+		propName := common.ToPascalCase(col.ColumnName)
+		if opt.CamelCaseProperties {
+			propName = common.ToCamelCase(col.ColumnName)
+		}
+
+		calls := validatorRules(dbType, col)
+		if len(calls) == 0 {
+			continue
+		}
+		rules = append(rules, fmt.Sprintf("        RuleFor(x => x.%s).%s;\n", propName, strings.Join(calls, ".")))
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	separator := ""
+	if opt.ExtraSpacing {
+		separator = "\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("using FluentValidation;\n\n")
+	sb.WriteString(fmt.Sprintf("public class %s : AbstractValidator<%s>\n{\n", validatorName, typeName))
+	sb.WriteString(fmt.Sprintf("    public %s()\n    {\n", validatorName))
+	sb.WriteString(strings.Join(rules, separator))
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+// validatorRules lists the rule calls for a column. Value types cannot hold
+// null unless declared nullable, so only reference types get NotNull, and
+// values assigned by the database are left to it.
+func validatorRules(dbType string, col domain.SqlData) []string {
+	var calls []string
+
+	generated := col.IsIdentity == "YES" || col.IsGenerated == "YES"
+	if !strings.EqualFold(col.IsNullable, "YES") && !generated && !IsValueType(FieldType(dbType, col)) {
+		calls = append(calls, "NotNull()")
+	}
+
+	dataType := strings.ToLower(col.DataType)
+	if strings.Contains(dataType, "char") && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		calls = append(calls, fmt.Sprintf("MaximumLength(%d)", col.CharacterMaximumLength.Int16))
+	}
+
+	if slices.Contains([]string{"decimal", "numeric"}, dataType) && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+		calls = append(calls, fmt.Sprintf("PrecisionScale(%d, %d, false)", col.NumericPrecision.Int16, col.NumericScale.Int16))
+	}
+
+	return calls
+}
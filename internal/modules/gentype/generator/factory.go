@@ -5,10 +5,16 @@ import (
 	"strings"
 
 	"github.com/khanalsaroj/typegen-server/internal/domain"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/avro"
 	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/csharp"
 	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/golang"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/graphql"
 	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/jsonschema"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/prisma"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/protobuf"
 	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/python"
+	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/scala"
 	"github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/typescript"
 )
 
@@ -23,6 +29,8 @@ func NewGenerator(req domain.TypeRequest) (Generator, error) {
 			return &java.Dto{}, nil
 		case "record":
 			return &java.Record{}, nil
+		case "entity", "jpa":
+			return &java.Entity{}, nil
 		default:
 			return nil, fmt.Errorf("unsupported java type: %s", req.Style)
 		}
@@ -32,6 +40,20 @@ func NewGenerator(req domain.TypeRequest) (Generator, error) {
 			return &typescript.Dto{}, nil
 		case "zod":
 			return &typescript.Zod{}, nil
+		case "valibot":
+			return &typescript.Valibot{}, nil
+		case "yup":
+			return &typescript.Yup{}, nil
+		case "io-ts", "iots":
+			return &typescript.IoTs{}, nil
+		case "arktype":
+			return &typescript.ArkType{}, nil
+		case "nestjs", "class-validator":
+			return &typescript.NestDto{}, nil
+		case "typeorm":
+			return &typescript.TypeOrm{}, nil
+		case "drizzle":
+			return &typescript.Drizzle{}, nil
 		default:
 			return nil, fmt.Errorf("unsupported typescript type: %s", req.Style)
 		}
@@ -41,6 +63,10 @@ func NewGenerator(req domain.TypeRequest) (Generator, error) {
 			return &csharp.Dto{}, nil
 		case "record":
 			return &csharp.Record{}, nil
+		case "efcore", "ef-core", "entity":
+			return &csharp.EfCore{}, nil
+		case "fluentvalidation", "fluent-validation", "validator":
+			return &csharp.Validator{}, nil
 		default:
 			return nil, fmt.Errorf("unsupported csharp type: %s", req.Style)
 		}
@@ -54,11 +80,51 @@ func NewGenerator(req domain.TypeRequest) (Generator, error) {
 			return &python.PydanticDto{}, nil
 		case "class":
 			return &python.Dto{}, nil
+		case "sqlalchemy":
+			return &python.SqlAlchemy{}, nil
+		case "sqlmodel":
+			return &python.SqlModel{}, nil
+		case "django":
+			return &python.Django{}, nil
+		case "msgspec":
+			return &python.Msgspec{}, nil
+		case "attrs":
+			return &python.Attrs{}, nil
+		case "marshmallow":
+			return &python.Marshmallow{}, nil
 		default:
 			return nil, fmt.Errorf("unsupported csharp type: %s", req.Style)
 		}
 	case "go":
-		return &golang.Dto{}, nil
+		switch style {
+		case "", "struct", "dto", "sqlc":
+			return &golang.Dto{}, nil
+		case "gorm":
+			return &golang.Gorm{}, nil
+		case "ent":
+			return &golang.Ent{}, nil
+		default:
+			return nil, fmt.Errorf("unsupported go type: %s", req.Style)
+		}
+	case "avro":
+		return &avro.Record{}, nil
+	case "graphql":
+		return &graphql.Sdl{}, nil
+	case "jsonschema", "json-schema":
+		return &jsonschema.JsonSchema{}, nil
+	case "openapi":
+		return &jsonschema.OpenApi{}, nil
+	case "prisma":
+		return &prisma.Schema{}, nil
+	case "protobuf", "proto":
+		return &protobuf.Message{}, nil
+	case "scala":
+		switch style {
+		case "case_class":
+			return &scala.CaseClass{}, nil
+		default:
+			return nil, fmt.Errorf("unsupported scala type: %s", req.Style)
+		}
 	}
 
 	return nil, fmt.Errorf("unsupported language type: %s", req.Style)
@@ -9,3 +9,15 @@ import (
 type Generator interface {
 	Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error)
 }
+
+// BundleHeader is implemented by generators that need shared declarations
+// written once at the top of a multi-table bundle.
+type BundleHeader interface {
+	Header(req domain.TypeRequest, dbType string) (string, error)
+}
+
+// BundleFooter is implemented by generators that need to close a multi-table
+// bundle once every table has been written.
+type BundleFooter interface {
+	Footer(req domain.TypeRequest, dbType string) (string, error)
+}
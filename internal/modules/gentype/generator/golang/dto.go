@@ -23,45 +23,33 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 		return "", fmt.Errorf("invalid Go options: %w", err)
 	}
 
-	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
-
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
+	// sqlc models are exported structs that use the database/sql null types.
+	if strings.EqualFold(req.Style, "sqlc") {
+		opt.ExportFields = true
+		if opt.NullTypes == "" {
+			opt.NullTypes = "sql"
 		}
+	}
+
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
 
-		goType := mapDBToGoType(dbType, dataType)
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
-		if opt.PointerFields && isNullable == "YES" {
-			goType = "*" + goType
-		}
+	for _, col := range columns {
+		goType := FieldType(dbType, col, opt)
 
-		fieldName := common.ToCamelCase(columnName)
+		fieldName := common.ToCamelCase(col.ColumnName)
 		if opt.ExportFields {
-			fieldName = common.ToPascalCase(columnName)
+			fieldName = common.ToPascalCase(col.ColumnName)
 		}
 
-		tags := buildTags(columnName, fieldName, opt, isNullable)
+		tags := buildTags(col.ColumnName, fieldName, opt, col.IsNullable)
 
-		if opt.Comments && columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
-			sb.WriteString(fmt.Sprintf("    // %s\n", columnComment.String))
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("    // %s\n", col.ColumnComment.String))
 		}
 
 		sb.WriteString(fmt.Sprintf("    %s %s %s\n", fieldName, goType, tags))
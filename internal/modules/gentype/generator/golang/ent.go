@@ -0,0 +1,240 @@
+package golang
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Ent writes ent schema definitions. Go rejects unused imports, so schemas
+// are collected by Generate and rendered by Footer with the imports they use.
+type Ent struct {
+	imports []string
+	schemas []string
+}
+
+var goIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (e *Ent) use(path string) {
+	if !slices.Contains(e.imports, path) {
+		e.imports = append(e.imports, path)
+	}
+}
+
+func (e *Ent) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	schemaName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.GoStructAdvancedOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "", fmt.Errorf("invalid Go options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var names, primaryKeys []string
+	for _, col := range columns {
+		names = append(names, col.ColumnName)
+		if strings.Contains(col.ColumnKey, "PRI") {
+			primaryKeys = append(primaryKeys, col.ColumnName)
+		}
+	}
+
+	var fields, edges []string
+	for _, col := range columns {
+		builder := e.fieldBuilder(dbType, col)
+
+		// ent names the primary key "id"; other names are kept as the storage key.
+		if len(primaryKeys) == 1 && col.ColumnName == primaryKeys[0] {
+			builder = strings.Replace(builder, fmt.Sprintf("(%q", col.ColumnName), `("id"`, 1)
+			if col.ColumnName != "id" {
+				builder += fmt.Sprintf(".\n            StorageKey(%q)", col.ColumnName)
+			}
+			if col.IsIdentity != "YES" {
+				builder += ".\n            Immutable()"
+			}
+		}
+
+		if col.IsNullable == "YES" {
+			builder += ".\n            Optional().\n            Nillable()"
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			builder += fmt.Sprintf(".\n            Comment(%q)", strings.TrimSpace(col.ColumnComment.String))
+		}
+
+		fields = append(fields, builder)
+
+		if target, ok := common.BundledReference(col, req); ok {
+			name := strings.TrimSuffix(col.ColumnName, "_id")
+			if name == col.ColumnName || name == "" || slices.Contains(names, name) {
+				name = strings.ToLower(target)
+			}
+			names = append(names, name)
+
+			edge := fmt.Sprintf(
+				"edge.To(%q, %s.Type).\n            Field(%q).\n            Unique()",
+				name,
+				req.Prefix+common.ToPascalCase(target)+req.Suffix,
+				col.ColumnName,
+			)
+			if col.IsNullable != "YES" {
+				edge += ".\n            Required()"
+			}
+			edges = append(edges, edge)
+		}
+	}
+
+	e.use("entgo.io/ent")
+	e.use("entgo.io/ent/dialect/entsql")
+	e.use("entgo.io/ent/schema")
+	e.use("entgo.io/ent/schema/field")
+
+	sb.WriteString(fmt.Sprintf("// %s holds the schema definition for the %s entity.\n", schemaName, schemaName))
+	if len(primaryKeys) > 1 {
+		sb.WriteString(fmt.Sprintf("// The composite primary key (%s) is not modelled; ent requires an edge schema for it.\n", strings.Join(primaryKeys, ", ")))
+	}
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", schemaName))
+	sb.WriteString("    ent.Schema\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// Annotations of the %s.\n", schemaName))
+	sb.WriteString(fmt.Sprintf("func (%s) Annotations() []schema.Annotation {\n", schemaName))
+	sb.WriteString("    return []schema.Annotation{\n")
+	sb.WriteString(fmt.Sprintf("        entsql.Annotation{Table: %q},\n", tbN))
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// Fields of the %s.\n", schemaName))
+	sb.WriteString(fmt.Sprintf("func (%s) Fields() []ent.Field {\n", schemaName))
+	sb.WriteString("    return []ent.Field{\n")
+	for _, f := range fields {
+		sb.WriteString(fmt.Sprintf("        %s,\n", f))
+	}
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+
+	if len(edges) > 0 {
+		e.use("entgo.io/ent/schema/edge")
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("// Edges of the %s.\n", schemaName))
+		sb.WriteString(fmt.Sprintf("func (%s) Edges() []ent.Edge {\n", schemaName))
+		sb.WriteString("    return []ent.Edge{\n")
+		for _, edge := range edges {
+			sb.WriteString(fmt.Sprintf("        %s,\n", edge))
+		}
+		sb.WriteString("    }\n")
+		sb.WriteString("}\n")
+	}
+
+	e.schemas = append(e.schemas, sb.String())
+
+	return "", nil
+}
+
+func (e *Ent) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("package schema\n\n")
+
+	slices.Sort(e.imports)
+	sb.WriteString("import (\n")
+	for _, path := range e.imports {
+		sb.WriteString(fmt.Sprintf("    %q\n", path))
+	}
+	sb.WriteString(")\n")
+
+	for _, s := range e.schemas {
+		sb.WriteString("\n")
+		sb.WriteString(s)
+	}
+
+	return sb.String(), nil
+}
+
+func (e *Ent) fieldBuilder(dbType string, col domain.SqlData) string {
+	name := col.ColumnName
+
+	if values := common.EnumValues(col); len(values) > 0 {
+		valid := true
+		var quoted []string
+		for _, value := range values {
+			valid = valid && goIdentifier.MatchString(value)
+			quoted = append(quoted, fmt.Sprintf("%q", value))
+		}
+		// ent turns enum values into Go identifiers.
+		if valid {
+			return fmt.Sprintf("field.Enum(%q).\n            Values(%s)", name, strings.Join(quoted, ", "))
+		}
+		return fmt.Sprintf("field.String(%q)", name)
+	}
+
+	if strings.EqualFold(dbType, "postgres") && strings.EqualFold(col.DataType, "uuid") {
+		e.use("github.com/google/uuid")
+		return fmt.Sprintf("field.UUID(%q, uuid.UUID{})", name)
+	}
+
+	switch goType := mapDBToGoType(dbType, col.DataType); goType {
+	case "int":
+		return fmt.Sprintf("field.Int(%q)", name)
+	case "int64":
+		return fmt.Sprintf("field.Int64(%q)", name)
+	case "int16":
+		return fmt.Sprintf("field.Int16(%q)", name)
+	case "int8":
+		return fmt.Sprintf("field.Int8(%q)", name)
+	case "float64":
+		builder := fmt.Sprintf("field.Float(%q)", name)
+		if d := entDialect(dbType); d != "" && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+			e.use("entgo.io/ent/dialect")
+			builder += fmt.Sprintf(".\n            SchemaType(map[string]string{%s: %q})", d, columnType(col))
+		}
+		return builder
+	case "string":
+		builder := fmt.Sprintf("field.String(%q)", name)
+		if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+			builder += fmt.Sprintf(".\n            MaxLen(%d)", col.CharacterMaximumLength.Int16)
+		}
+		return builder
+	case "bool":
+		return fmt.Sprintf("field.Bool(%q)", name)
+	case "time.Time":
+		return fmt.Sprintf("field.Time(%q)", name)
+	case "[]byte":
+		return fmt.Sprintf("field.Bytes(%q)", name)
+	case "[]int":
+		return fmt.Sprintf("field.Ints(%q)", name)
+	case "[]string":
+		return fmt.Sprintf("field.Strings(%q)", name)
+	case "map[string]interface{}":
+		return fmt.Sprintf("field.JSON(%q, map[string]interface{}{})", name)
+	default:
+		if strings.HasPrefix(goType, "[]") {
+			return fmt.Sprintf("field.JSON(%q, %s{})", name, goType)
+		}
+		return fmt.Sprintf("field.String(%q)", name)
+	}
+}
+
+// entDialect names the ent dialect constant; ent has none for SQL Server.
+func entDialect(dbType string) string {
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return "dialect.MySQL"
+	case "postgres":
+		return "dialect.Postgres"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,169 @@
+package golang
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+type Gorm struct{}
+
+func (g *Gorm) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	structName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.GoStructAdvancedOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "", fmt.Errorf("invalid Go options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var fieldNames, primaryKeys []string
+	for _, col := range columns {
+		fieldNames = append(fieldNames, common.ToPascalCase(col.ColumnName))
+		if strings.Contains(col.ColumnKey, "PRI") {
+			primaryKeys = append(primaryKeys, col.ColumnName)
+		}
+	}
+
+	var relations []string
+
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
+
+	for i, col := range columns {
+		fieldName := fieldNames[i]
+		goType := FieldType(dbType, col, opt)
+
+		gormTag := []string{"column:" + col.ColumnName}
+		if strings.Contains(col.ColumnKey, "PRI") {
+			gormTag = append(gormTag, "primaryKey")
+		}
+		if col.IsIdentity == "YES" {
+			gormTag = append(gormTag, "autoIncrement")
+		} else if strings.Contains(col.ColumnKey, "PRI") && len(primaryKeys) == 1 {
+			// GORM assumes integer primary keys are auto incremented unless told otherwise.
+			gormTag = append(gormTag, "autoIncrement:false")
+		}
+		gormTag = append(gormTag, "type:"+columnType(col))
+		if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+			gormTag = append(gormTag, fmt.Sprintf("size:%d", col.CharacterMaximumLength.Int16))
+		}
+		if col.IsNullable != "YES" {
+			gormTag = append(gormTag, "not null")
+		}
+		if strings.Contains(col.ColumnKey, "UNI") {
+			gormTag = append(gormTag, "unique")
+		}
+		// Computed columns are read only.
+		if col.IsGenerated == "YES" {
+			gormTag = append(gormTag, "->")
+		}
+
+		tags := []string{fmt.Sprintf(`gorm:"%s"`, strings.Join(gormTag, ";"))}
+		if opt.JsonTags {
+			jsonTag := col.ColumnName
+			if opt.OmitEmpty && col.IsNullable == "YES" {
+				jsonTag += ",omitempty"
+			}
+			tags = append(tags, fmt.Sprintf(`json:"%s"`, jsonTag))
+		}
+		if opt.ValidateTags && col.IsNullable == "NO" {
+			tags = append(tags, `validate:"required"`)
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("    // %s\n", col.ColumnComment.String))
+		}
+
+		sb.WriteString(fmt.Sprintf("    %s %s `%s`\n", fieldName, goType, strings.Join(tags, " ")))
+
+		if opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		if target, ok := common.BundledReference(col, req); ok {
+			name := relationField(fieldName, target, fieldNames)
+			fieldNames = append(fieldNames, name)
+
+			relation := fmt.Sprintf(
+				"    %s *%s `gorm:\"foreignKey:%s;references:%s\"`",
+				name,
+				req.Prefix+common.ToPascalCase(target)+req.Suffix,
+				fieldName,
+				common.ToPascalCase(col.ReferencedColumn.String),
+			)
+			if opt.JsonTags {
+				relation = strings.TrimSuffix(relation, "`") + fmt.Sprintf(" json:\"%s,omitempty\"`", common.ToSnakeCase(name))
+			}
+			relations = append(relations, relation)
+		}
+	}
+
+	if len(relations) > 0 {
+		if !opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(strings.Join(relations, "\n"))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("func (%s) TableName() string {\n", structName))
+	sb.WriteString(fmt.Sprintf("    return %q\n", tbN))
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+// columnType renders the column's database type with its length or precision.
+func columnType(col domain.SqlData) string {
+	dataType := strings.ToLower(col.DataType)
+
+	if values := common.EnumValues(col); len(values) > 0 && dataType == "enum" {
+		var quoted []string
+		for _, value := range values {
+			quoted = append(quoted, "'"+strings.ReplaceAll(value, "'", "''")+"'")
+		}
+		return fmt.Sprintf("enum(%s)", strings.Join(quoted, ","))
+	}
+
+	if col.CharacterMaximumLength.Valid {
+		switch n := col.CharacterMaximumLength.Int16; {
+		case n < 0:
+			return dataType + "(max)"
+		case n > 0 && slices.Contains([]string{"varchar", "char", "bpchar", "nvarchar", "nchar", "binary", "varbinary"}, dataType):
+			return fmt.Sprintf("%s(%d)", dataType, n)
+		}
+	}
+
+	if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 && slices.Contains([]string{"decimal", "numeric"}, dataType) {
+		return fmt.Sprintf("%s(%d,%d)", dataType, col.NumericPrecision.Int16, col.NumericScale.Int16)
+	}
+
+	return dataType
+}
+
+// relationField names the association after its foreign key (UserId becomes
+// User), falling back to the target table name.
+func relationField(fieldName, target string, taken []string) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(fieldName, "Id"), "ID")
+	if name == "" || name == fieldName {
+		name = common.ToPascalCase(target)
+	}
+	candidate := name
+	for i := 2; slices.Contains(taken, candidate); i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	return candidate
+}
@@ -0,0 +1,63 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// FieldType returns the Go type of a column, applying the requested
+// representation for nullable columns: pointers, database/sql null types
+// ("sql") or the generic sql.Null[T] ("generic").
+func FieldType(dbType string, col domain.SqlData, opt domain.GoStructAdvancedOptions) string {
+	goType := mapDBToGoType(dbType, col.DataType)
+
+	if col.IsNullable != "YES" {
+		return goType
+	}
+
+	switch strings.ToLower(opt.NullTypes) {
+	case "sql":
+		if nilable(goType) {
+			return goType
+		}
+		return sqlNullType(goType)
+	case "generic":
+		if nilable(goType) {
+			return goType
+		}
+		return fmt.Sprintf("sql.Null[%s]", goType)
+	}
+
+	if opt.PointerFields {
+		return "*" + goType
+	}
+	return goType
+}
+
+// nilable reports whether the type already has a nil value.
+func nilable(goType string) bool {
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || goType == "interface{}"
+}
+
+func sqlNullType(goType string) string {
+	switch goType {
+	case "string":
+		return "sql.NullString"
+	case "int64":
+		return "sql.NullInt64"
+	case "int":
+		return "sql.NullInt32"
+	case "int16":
+		return "sql.NullInt16"
+	case "float64":
+		return "sql.NullFloat64"
+	case "bool":
+		return "sql.NullBool"
+	case "time.Time":
+		return "sql.NullTime"
+	default:
+		return fmt.Sprintf("sql.Null[%s]", goType)
+	}
+}
@@ -0,0 +1,250 @@
+package graphql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+type Sdl struct{}
+
+func (s *Sdl) Header(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("scalar DateTime\n")
+	sb.WriteString("scalar Decimal\n")
+	sb.WriteString("scalar JSON\n")
+	sb.WriteString("scalar Long\n")
+	sb.WriteString("scalar UUID\n\n")
+
+	return sb.String(), nil
+}
+
+func (s *Sdl) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.GraphQLOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid GraphQL Options", fmt.Errorf("invalid GraphQL options: %w", err)
+	}
+
+	type field struct {
+		Name        string
+		Type        string
+		Description string
+		Nullable    bool
+		PrimaryKey  bool
+		Generated   bool
+	}
+
+	var fields []field
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	for _, col := range columns {
+		primaryKey := strings.Contains(col.ColumnKey, "PRI")
+
+		var gqlType string
+		if primaryKey {
+			gqlType = "ID"
+		} else {
+			switch strings.ToLower(dbType) {
+			case "mysql":
+				gqlType = mapMySQLToGraphQLType(col.DataType)
+			case "postgres":
+				gqlType = mapPostgresToGraphQLType(col.DataType)
+			case "mssql":
+				gqlType = mapMSSQLToGraphQLType(col.DataType)
+			default:
+				gqlType = "String"
+			}
+		}
+
+		description := ""
+		if col.ColumnComment.Valid {
+			description = strings.TrimSpace(col.ColumnComment.String)
+		}
+
+		fields = append(fields, field{
+			Name:        common.ToCamelCase(col.ColumnName),
+			Type:        gqlType,
+			Description: description,
+			Nullable:    strings.EqualFold(col.IsNullable, "YES"),
+			PrimaryKey:  primaryKey,
+			Generated:   col.IsIdentity == "YES" || col.IsGenerated == "YES",
+		})
+	}
+
+	writeField := func(f field, required bool) {
+		if opt.Descriptions && f.Description != "" {
+			sb.WriteString(fmt.Sprintf("  %s\n", quoteDescription(f.Description)))
+		}
+		nonNull := ""
+		if required {
+			nonNull = "!"
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s%s\n", f.Name, f.Type, nonNull))
+		if opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("type %s {\n", typeName))
+	for _, f := range fields {
+		writeField(f, !f.Nullable)
+	}
+	sb.WriteString("}\n")
+
+	// Create inputs skip columns the database fills in itself. Update inputs
+	// are partial; the primary key identifies the row separately.
+	var create, update []field
+	for _, f := range fields {
+		if f.Generated {
+			continue
+		}
+		create = append(create, f)
+		if !f.PrimaryKey {
+			update = append(update, f)
+		}
+	}
+
+	// An input type without fields is invalid SDL, so it is left out.
+	if len(create) > 0 {
+		sb.WriteString(fmt.Sprintf("\ninput Create%sInput {\n", typeName))
+		for _, f := range create {
+			writeField(f, !f.Nullable)
+		}
+		sb.WriteString("}\n")
+	}
+	if len(update) > 0 {
+		sb.WriteString(fmt.Sprintf("\ninput Update%sInput {\n", typeName))
+		for _, f := range update {
+			writeField(f, false)
+		}
+		sb.WriteString("}\n")
+	}
+
+	return sb.String(), nil
+}
+
+func quoteDescription(description string) string {
+	description = strings.ReplaceAll(description, `\`, `\\`)
+	description = strings.ReplaceAll(description, `"`, `\"`)
+	description = strings.ReplaceAll(description, "\n", " ")
+	return `"` + description + `"`
+}
+
+func mapMySQLToGraphQLType(mysqlType string) string {
+	switch strings.ToLower(mysqlType) {
+
+	case "int", "integer", "mediumint", "smallint", "tinyint", "year":
+		return "Int"
+	// GraphQL's Int is 32-bit signed.
+	case "bigint":
+		return "Long"
+
+	case "decimal", "numeric":
+		return "Decimal"
+	case "float", "double":
+		return "Float"
+
+	case "varchar", "char", "text", "longtext", "mediumtext", "tinytext", "enum":
+		return "String"
+
+	case "date", "datetime", "timestamp", "time":
+		return "DateTime"
+
+	case "boolean", "bit":
+		return "Boolean"
+
+	case "json":
+		return "JSON"
+
+	default:
+		return "String"
+	}
+}
+
+func mapPostgresToGraphQLType(pgType string) string {
+	switch strings.ToLower(pgType) {
+
+	case "smallint", "int2", "integer", "int", "int4", "serial":
+		return "Int"
+	case "bigint", "int8", "bigserial":
+		return "Long"
+
+	case "decimal", "numeric", "money":
+		return "Decimal"
+	case "real", "float4", "double precision", "float8":
+		return "Float"
+
+	case "varchar", "character varying", "char", "character", "bpchar", "text", "citext",
+		"inet", "cidr", "macaddr", "xml":
+		return "String"
+
+	case "date", "time", "time without time zone",
+		"timestamp", "timestamp without time zone",
+		"timestamptz", "timestamp with time zone":
+		return "DateTime"
+
+	case "boolean", "bool":
+		return "Boolean"
+
+	case "uuid":
+		return "UUID"
+
+	case "json", "jsonb":
+		return "JSON"
+
+	case "_int4", "integer[]":
+		return "[Int]"
+	case "_int8", "bigint[]":
+		return "[Long]"
+	case "_text", "text[]":
+		return "[String]"
+	case "_uuid", "uuid[]":
+		return "[UUID]"
+
+	default:
+		return "String"
+	}
+}
+
+func mapMSSQLToGraphQLType(mssqlType string) string {
+	switch strings.ToLower(mssqlType) {
+
+	case "int", "smallint", "tinyint":
+		return "Int"
+	case "bigint":
+		return "Long"
+
+	case "decimal", "numeric", "money", "smallmoney":
+		return "Decimal"
+	case "float", "real":
+		return "Float"
+
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext", "xml":
+		return "String"
+
+	case "date", "time", "datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return "DateTime"
+
+	case "bit":
+		return "Boolean"
+
+	case "uniqueidentifier":
+		return "UUID"
+
+	default:
+		return "String"
+	}
+}
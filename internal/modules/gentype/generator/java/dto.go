@@ -15,6 +15,7 @@ type Dto struct{}
 func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
 
 	var sb strings.Builder
+	var validation constraints
 	serializable := ""
 
 	tableName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
@@ -51,46 +52,35 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 	}
 	sb.WriteString(fmt.Sprintf("public class %s %s{\n", tableName, serializable))
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var taken []string
+	for _, col := range columns {
+		taken = append(taken, common.ToCamelCase(col.ColumnName))
+	}
 
+	for _, col := range columns {
 		var javaType string
 
 		switch strings.ToLower(dbType) {
 		case "mysql":
-			javaType = mapMySQLToJavaType(dataType)
+			javaType = mapMySQLToJavaType(col.DataType)
 		case "postgres":
-			javaType = mapPostgresToJavaType(dataType)
+			javaType = mapPostgresToJavaType(col.DataType)
 		case "mssql":
-			javaType = mapMSSQLToJavaType(dataType)
+			javaType = mapMSSQLToJavaType(col.DataType)
 		default:
 			javaType = "any"
 		}
 
-		fieldName := common.ToCamelCase(columnName)
+		fieldName := common.ToCamelCase(col.ColumnName)
 
-		if columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
+		if col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
 			if opt.SwaggerAnnotations {
-				sb.WriteString(fmt.Sprintf("    @Schema(description = \" %s\")\n", columnComment.String))
+				sb.WriteString(fmt.Sprintf("    @Schema(description = \" %s\")\n", col.ColumnComment.String))
 			}
 		}
 
@@ -98,11 +88,24 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 			sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", fieldName))
 		}
 
+		if opt.BeanValidation {
+			for _, a := range validation.annotations(col, javaType) {
+				sb.WriteString(fmt.Sprintf("    %s\n", a))
+			}
+		}
+
 		sb.WriteString(fmt.Sprintf(
 			"    private %s %s;\n",
 			javaType,
 			fieldName,
 		))
+		if opt.BeanValidation {
+			if nestedType, nestedName, ok := validation.nested(col, req, taken); ok {
+				taken = append(taken, nestedName)
+				sb.WriteString(fmt.Sprintf("    %s\n", validation.use("Valid")))
+				sb.WriteString(fmt.Sprintf("    private %s %s;\n", nestedType, nestedName))
+			}
+		}
 		if opt.ExtraSpacing {
 			sb.WriteString(fmt.Sprintf("\n"))
 		}
@@ -114,7 +117,24 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 		return "", err
 	}
 
-	return sb.String(), nil
+	var out strings.Builder
+	validation.write(&out)
+	out.WriteString(sb.String())
+	return out.String(), nil
+}
+
+// FieldType maps a column to the Java type used for it by the DTO generators.
+func FieldType(dbType string, col domain.SqlData) string {
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return mapMySQLToJavaType(col.DataType)
+	case "postgres":
+		return mapPostgresToJavaType(col.DataType)
+	case "mssql":
+		return mapMSSQLToJavaType(col.DataType)
+	default:
+		return "Object"
+	}
 }
 
 func mapMySQLToJavaType(mysqlType string) string {
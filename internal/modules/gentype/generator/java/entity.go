@@ -0,0 +1,476 @@
+package java
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Entity writes Jakarta Persistence entities. The inverse side of a foreign
+// key lives on the referenced entity, so tables are collected by Generate and
+// rendered by Footer once every table in the request is known.
+type Entity struct {
+	opt      domain.JpaEntityOptions
+	entities []*entity
+	imports  []string
+}
+
+type entity struct {
+	ClassName string
+	Table     string
+	Columns   []domain.SqlData
+	Keys      []domain.SqlData
+	Relations []entityRelation
+	Inverse   []entityRelation
+	dbType    string
+	members   []string
+}
+
+type entityRelation struct {
+	Field    string
+	Column   string
+	RefCol   string
+	Target   *entity
+	Source   *entity
+	Inverse  string
+	Nullable bool
+}
+
+var javaIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func (e *Entity) use(imports ...string) {
+	for _, i := range imports {
+		if !slices.Contains(e.imports, i) {
+			e.imports = append(e.imports, i)
+		}
+	}
+}
+
+func (e *Entity) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	if err := json.Unmarshal(req.Options, &e.opt); err != nil {
+		return "Invalid Entity Options", fmt.Errorf("invalid Entity options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	e.entities = append(e.entities, &entity{
+		ClassName: req.Prefix + common.ToPascalCase(tbN) + req.Suffix,
+		Table:     tbN,
+		Columns:   columns,
+		Keys:      common.PrimaryKeys(columns),
+		dbType:    dbType,
+	})
+
+	return "", nil
+}
+
+func (e *Entity) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	e.resolveRelations()
+
+	var bodies []string
+	for _, en := range e.entities {
+		bodies = append(bodies, e.writeEntity(en))
+	}
+
+	var sb strings.Builder
+
+	slices.Sort(e.imports)
+	for _, i := range e.imports {
+		sb.WriteString(fmt.Sprintf("import %s;\n", i))
+	}
+
+	for _, body := range bodies {
+		sb.WriteString("\n")
+		sb.WriteString(body)
+	}
+
+	return sb.String(), nil
+}
+
+func (e *Entity) findEntity(table string) *entity {
+	for _, en := range e.entities {
+		if strings.EqualFold(en.Table, table) {
+			return en
+		}
+	}
+	return nil
+}
+
+// resolveRelations turns foreign keys to bundled tables into @ManyToOne
+// fields and records the matching @OneToMany on the referenced entity.
+func (e *Entity) resolveRelations() {
+	for _, en := range e.entities {
+		for _, col := range en.Columns {
+			en.members = append(en.members, common.ToCamelCase(col.ColumnName))
+		}
+	}
+
+	for _, en := range e.entities {
+		for _, col := range en.Columns {
+			if !col.ReferencedTable.Valid || common.IsPrimaryKey(col) {
+				continue
+			}
+			target := e.findEntity(col.ReferencedTable.String)
+			if target == nil {
+				continue
+			}
+
+			var field string
+			if name := strings.TrimSuffix(common.ToCamelCase(col.ColumnName), "Id"); name != common.ToCamelCase(col.ColumnName) {
+				field = en.member(name, lowerFirst(target.ClassName))
+			} else {
+				field = en.member(lowerFirst(target.ClassName))
+			}
+
+			en.Relations = append(en.Relations, entityRelation{
+				Field:    field,
+				Column:   col.ColumnName,
+				RefCol:   col.ReferencedColumn.String,
+				Target:   target,
+				Source:   en,
+				Nullable: strings.EqualFold(col.IsNullable, "YES"),
+			})
+		}
+	}
+
+	for _, en := range e.entities {
+		for i := range en.Relations {
+			r := &en.Relations[i]
+			inverse := common.ToCamelCase(en.Table)
+			for _, other := range en.Relations {
+				if other.Target == r.Target && other.Field != r.Field {
+					inverse += upperFirst(r.Field)
+					break
+				}
+			}
+			r.Inverse = r.Target.member(inverse, "inverse"+upperFirst(r.Field))
+			r.Target.Inverse = append(r.Target.Inverse, *r)
+		}
+	}
+}
+
+// member claims the first candidate that is not already a field of the
+// entity, numbering the last one when all are taken.
+func (en *entity) member(candidates ...string) string {
+	free := func(name string) bool {
+		return name != "" && !slices.Contains(en.members, name)
+	}
+	name := candidates[len(candidates)-1]
+	if i := slices.IndexFunc(candidates, free); i >= 0 {
+		name = candidates[i]
+	} else {
+		for n := 2; !free(name); n++ {
+			name = fmt.Sprintf("%s%d", candidates[len(candidates)-1], n)
+		}
+	}
+	en.members = append(en.members, name)
+	return name
+}
+
+func (e *Entity) writeEntity(en *entity) string {
+	var sb strings.Builder
+	opt := e.opt
+
+	e.use("jakarta.persistence.*")
+
+	if e.writeLombok(&sb) {
+		e.use("lombok.*")
+	}
+	sb.WriteString("@Entity\n")
+	if opt.Schema != "" {
+		sb.WriteString(fmt.Sprintf("@Table(name = \"%s\", schema = \"%s\")\n", en.Table, opt.Schema))
+	} else {
+		sb.WriteString(fmt.Sprintf("@Table(name = \"%s\")\n", en.Table))
+	}
+
+	composite := len(en.Keys) > 1
+	if composite {
+		sb.WriteString(fmt.Sprintf("@IdClass(%s.%sId.class)\n", en.ClassName, en.ClassName))
+	}
+
+	serializable := ""
+	if opt.Serializable {
+		e.use("java.io.Serializable")
+		serializable = "implements Serializable "
+	}
+	sb.WriteString(fmt.Sprintf("public class %s %s{\n", en.ClassName, serializable))
+
+	var enums []string
+	first := true
+	separate := func() {
+		if !first && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+		first = false
+	}
+
+	for _, col := range en.Columns {
+		if slices.ContainsFunc(en.Relations, func(r entityRelation) bool { return r.Column == col.ColumnName }) {
+			continue
+		}
+
+		fieldName := common.ToCamelCase(col.ColumnName)
+		javaType := e.javaType(en.dbType, col)
+
+		separate()
+
+		if opt.SwaggerAnnotations && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			e.use("io.swagger.v3.oas.annotations.media.Schema")
+			sb.WriteString(fmt.Sprintf("    @Schema(description = \"%s\")\n", col.ColumnComment.String))
+		}
+
+		if common.IsPrimaryKey(col) {
+			sb.WriteString("    @Id\n")
+			if col.IsIdentity == "YES" {
+				sb.WriteString("    @GeneratedValue(strategy = GenerationType.IDENTITY)\n")
+			}
+		}
+
+		if e.isVersion(col, javaType) {
+			sb.WriteString("    @Version\n")
+		}
+
+		if isLob(en.dbType, col) {
+			sb.WriteString("    @Lob\n")
+		}
+
+		if values := common.EnumValues(col); len(values) > 0 && validJavaIdentifiers(values) {
+			javaType = common.ToPascalCase(col.ColumnName)
+			sb.WriteString("    @Enumerated(EnumType.STRING)\n")
+			enums = append(enums, fmt.Sprintf("    public enum %s {\n        %s\n    }\n", javaType, strings.Join(values, ",\n        ")))
+		}
+
+		sb.WriteString(fmt.Sprintf("    @Column(%s)\n", strings.Join(columnAttributes(col), ", ")))
+
+		if opt.JacksonAnnotations {
+			e.use("com.fasterxml.jackson.annotation.JsonProperty")
+			sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", fieldName))
+		}
+
+		sb.WriteString(fmt.Sprintf("    private %s %s;\n", javaType, fieldName))
+	}
+
+	for _, r := range en.Relations {
+		separate()
+		e.writeExcludes(&sb)
+		sb.WriteString("    @ManyToOne(fetch = FetchType.LAZY")
+		if !r.Nullable {
+			sb.WriteString(", optional = false")
+		}
+		sb.WriteString(")\n")
+		sb.WriteString(fmt.Sprintf("    @JoinColumn(name = \"%s\", referencedColumnName = \"%s\"", r.Column, r.RefCol))
+		if !r.Nullable {
+			sb.WriteString(", nullable = false")
+		}
+		sb.WriteString(")\n")
+		sb.WriteString(fmt.Sprintf("    private %s %s;\n", r.Target.ClassName, r.Field))
+	}
+
+	for _, r := range en.Inverse {
+		separate()
+		e.use("java.util.ArrayList", "java.util.List")
+		e.writeExcludes(&sb)
+		if opt.Builder {
+			sb.WriteString("    @Builder.Default\n")
+		}
+		sb.WriteString(fmt.Sprintf("    @OneToMany(mappedBy = \"%s\")\n", r.Field))
+		sb.WriteString(fmt.Sprintf("    private List<%s> %s = new ArrayList<>();\n", r.Source.ClassName, r.Inverse))
+	}
+
+	for _, enum := range enums {
+		sb.WriteString("\n")
+		sb.WriteString(enum)
+	}
+
+	if composite {
+		sb.WriteString("\n")
+		e.writeIdClass(&sb, en)
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// writeLombok writes the class level Lombok annotations and reports whether any were used.
+func (e *Entity) writeLombok(sb *strings.Builder) bool {
+	opt := e.opt
+	used := false
+	write := func(annotation string) {
+		sb.WriteString(annotation + "\n")
+		used = true
+	}
+
+	if opt.Data {
+		write("@Data")
+	} else {
+		if opt.Getter {
+			write("@Getter")
+		}
+		if opt.Setter {
+			write("@Setter")
+		}
+	}
+	if opt.NoArgsConstructor {
+		write("@NoArgsConstructor")
+	}
+	if opt.AllArgsConstructor {
+		write("@AllArgsConstructor")
+	}
+	if opt.Builder {
+		write("@Builder")
+	}
+
+	return used
+}
+
+// writeExcludes keeps relations out of Lombok's generated toString, equals
+// and hashCode, which would otherwise recurse through both sides.
+func (e *Entity) writeExcludes(sb *strings.Builder) {
+	if e.opt.Data {
+		sb.WriteString("    @ToString.Exclude\n")
+		sb.WriteString("    @EqualsAndHashCode.Exclude\n")
+	}
+}
+
+func (e *Entity) writeIdClass(sb *strings.Builder, en *entity) {
+	e.use("java.io.Serializable", "java.util.Objects")
+
+	idClass := en.ClassName + "Id"
+	var names []string
+
+	sb.WriteString(fmt.Sprintf("    public static class %s implements Serializable {\n", idClass))
+	for _, key := range en.Keys {
+		name := common.ToCamelCase(key.ColumnName)
+		names = append(names, name)
+		sb.WriteString(fmt.Sprintf("        private %s %s;\n", e.javaType(en.dbType, key), name))
+	}
+
+	var comparisons []string
+	for _, name := range names {
+		comparisons = append(comparisons, fmt.Sprintf("Objects.equals(%s, that.%s)", name, name))
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString("        @Override\n")
+	sb.WriteString("        public boolean equals(Object o) {\n")
+	sb.WriteString("            if (this == o) return true;\n")
+	sb.WriteString(fmt.Sprintf("            if (!(o instanceof %s that)) return false;\n", idClass))
+	sb.WriteString(fmt.Sprintf("            return %s;\n", strings.Join(comparisons, "\n                && ")))
+	sb.WriteString("        }\n\n")
+	sb.WriteString("        @Override\n")
+	sb.WriteString("        public int hashCode() {\n")
+	sb.WriteString(fmt.Sprintf("            return Objects.hash(%s);\n", strings.Join(names, ", ")))
+	sb.WriteString("        }\n")
+	sb.WriteString("    }\n")
+}
+
+func (e *Entity) javaType(dbType string, col domain.SqlData) string {
+	javaType := FieldType(dbType, col)
+
+	switch strings.TrimSuffix(javaType, "[]") {
+	case "BigDecimal":
+		e.use("java.math.BigDecimal")
+	case "LocalDate", "LocalDateTime", "LocalTime", "OffsetDateTime", "Year":
+		e.use("java.time." + strings.TrimSuffix(javaType, "[]"))
+	case "UUID":
+		e.use("java.util.UUID")
+	}
+
+	return javaType
+}
+
+func (e *Entity) isVersion(col domain.SqlData, javaType string) bool {
+	name := strings.ToLower(col.ColumnName)
+	if e.opt.VersionColumn != "" {
+		return strings.EqualFold(e.opt.VersionColumn, name)
+	}
+	if !common.IsVersionColumn(name) {
+		return false
+	}
+	// Only numeric and timestamp versions are supported by the specification.
+	switch strings.TrimPrefix(javaType, "java.time.") {
+	case "Integer", "Long", "Short", "LocalDateTime", "OffsetDateTime":
+		return true
+	default:
+		return false
+	}
+}
+
+func isLob(dbType string, col domain.SqlData) bool {
+	dataType := strings.ToLower(col.DataType)
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return slices.Contains([]string{"text", "mediumtext", "longtext", "blob", "mediumblob", "longblob"}, dataType)
+	case "mssql":
+		if slices.Contains([]string{"text", "ntext", "image"}, dataType) {
+			return true
+		}
+		// varchar(max) and friends report a length of -1.
+		return col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 < 0
+	default:
+		// Postgres text and bytea map directly; @Lob would switch them to large objects.
+		return false
+	}
+}
+
+func columnAttributes(col domain.SqlData) []string {
+	attributes := []string{fmt.Sprintf("name = \"%s\"", col.ColumnName)}
+
+	if !strings.EqualFold(col.IsNullable, "YES") {
+		attributes = append(attributes, "nullable = false")
+	}
+
+	if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		attributes = append(attributes, fmt.Sprintf("length = %d", col.CharacterMaximumLength.Int16))
+	}
+
+	dataType := strings.ToLower(col.DataType)
+	if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 && slices.Contains([]string{"decimal", "numeric"}, dataType) {
+		attributes = append(attributes, fmt.Sprintf("precision = %d", col.NumericPrecision.Int16))
+		attributes = append(attributes, fmt.Sprintf("scale = %d", col.NumericScale.Int16))
+	}
+
+	if strings.Contains(col.ColumnKey, "UNI") {
+		attributes = append(attributes, "unique = true")
+	}
+
+	// Computed columns are maintained by the database.
+	if col.IsGenerated == "YES" {
+		attributes = append(attributes, "insertable = false", "updatable = false")
+	}
+
+	return attributes
+}
+
+func validJavaIdentifiers(values []string) bool {
+	for _, value := range values {
+		if !javaIdentifier.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
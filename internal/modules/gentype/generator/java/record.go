@@ -14,6 +14,7 @@ type Record struct{}
 
 func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
 	var sb strings.Builder
+	var validation constraints
 	tableName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
 
 	var opt domain.RecordOptions
@@ -26,50 +27,40 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 	}
 	sb.WriteString(fmt.Sprintf("public record %s (\n", tableName))
 	var fields []string
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		if err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		); err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	var taken []string
+	for _, col := range columns {
+		taken = append(taken, common.ToCamelCase(col.ColumnName))
+	}
+
+	for _, col := range columns {
 		var javaType string
 
 		switch strings.ToLower(dbType) {
 		case "mysql":
-			javaType = mapMySQLToJavaType(dataType)
+			javaType = mapMySQLToJavaType(col.DataType)
 		case "postgres":
-			javaType = mapPostgresToJavaType(dataType)
+			javaType = mapPostgresToJavaType(col.DataType)
 		case "mssql":
-			javaType = mapMSSQLToJavaType(dataType)
+			javaType = mapMSSQLToJavaType(col.DataType)
 		default:
 			javaType = "any"
 		}
 
-		fieldName := common.ToCamelCase(columnName)
+		fieldName := common.ToCamelCase(col.ColumnName)
 
 		var fieldSb strings.Builder
 
 		// Swagger annotation
-		if columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
+		if col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
 			if opt.SwaggerAnnotations {
 				fieldSb.WriteString(fmt.Sprintf(
 					"    @Schema(description = \"%s\")\n",
-					columnComment.String,
+					col.ColumnComment.String,
 				))
 			}
 		}
@@ -82,6 +73,13 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 			))
 		}
 
+		// Bean Validation constraints
+		if opt.BeanValidation {
+			for _, a := range validation.annotations(col, javaType) {
+				fieldSb.WriteString(fmt.Sprintf("    %s\n", a))
+			}
+		}
+
 		// Field definition (NO comma here)
 		fieldSb.WriteString(fmt.Sprintf(
 			"    %s %s",
@@ -90,6 +88,13 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 		))
 
 		fields = append(fields, fieldSb.String())
+
+		if opt.BeanValidation {
+			if nestedType, nestedName, ok := validation.nested(col, req, taken); ok {
+				taken = append(taken, nestedName)
+				fields = append(fields, fmt.Sprintf("    %s\n    %s %s", validation.use("Valid"), nestedType, nestedName))
+			}
+		}
 	}
 
 	if err := rows.Err(); err != nil {
@@ -109,6 +114,9 @@ func (d *Record) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, db
 		return "", err
 	}
 
-	return sb.String(), nil
+	var out strings.Builder
+	validation.write(&out)
+	out.WriteString(sb.String())
+	return out.String(), nil
 
 }
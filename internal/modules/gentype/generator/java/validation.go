@@ -0,0 +1,103 @@
+package java
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// constraints collects the Jakarta Validation annotations for DTO fields
+// and the imports they need.
+type constraints struct {
+	imports []string
+}
+
+func (c *constraints) use(name string) string {
+	path := "jakarta.validation.constraints." + name
+	if name == "Valid" {
+		path = "jakarta.validation.Valid"
+	}
+	if !slices.Contains(c.imports, path) {
+		c.imports = append(c.imports, path)
+	}
+	return "@" + name
+}
+
+// annotations derives the constraints for a column from its nullability,
+// length, precision and name.
+func (c *constraints) annotations(col domain.SqlData, javaType string) []string {
+	var out []string
+
+	// Identity and computed values are assigned by the database, so a DTO
+	// built by a client legitimately leaves them empty.
+	generated := col.IsIdentity == "YES" || col.IsGenerated == "YES"
+	if strings.EqualFold(col.IsNullable, "NO") && !generated {
+		out = append(out, c.use("NotNull"))
+	}
+
+	switch javaType {
+	case "String":
+		if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+			out = append(out, fmt.Sprintf("%s(max = %d)", c.use("Size"), col.CharacterMaximumLength.Int16))
+		}
+		if values := common.EnumValues(col); len(values) > 0 {
+			out = append(out, fmt.Sprintf("%s(regexp = \"%s\")", c.use("Pattern"), enumPattern(values)))
+		} else if strings.Contains(strings.ToLower(col.ColumnName), "email") {
+			out = append(out, c.use("Email"))
+		}
+	case "BigDecimal", "java.math.BigDecimal":
+		if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+			scale := col.NumericScale.Int16
+			out = append(out, fmt.Sprintf("%s(integer = %d, fraction = %d)",
+				c.use("Digits"), col.NumericPrecision.Int16-scale, scale))
+		}
+	}
+
+	return out
+}
+
+// nested returns the type and name of the field that holds the DTO a
+// foreign key column references, when that table is generated in the same
+// request. Its constraints cascade through @Valid.
+func (c *constraints) nested(col domain.SqlData, req domain.TypeRequest, taken []string) (string, string, bool) {
+	target, ok := common.BundledReference(col, req)
+	if !ok {
+		return "", "", false
+	}
+	className := req.Prefix + common.ToPascalCase(target) + req.Suffix
+
+	field := strings.TrimSuffix(common.ToCamelCase(col.ColumnName), "Id")
+	if field == "" || field == common.ToCamelCase(col.ColumnName) || slices.Contains(taken, field) {
+		field = lowerFirst(className)
+	}
+	if slices.Contains(taken, field) {
+		return "", "", false
+	}
+	return className, field, true
+}
+
+// enumPattern matches exactly one of the labels, as the body of a Java
+// string literal.
+func enumPattern(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = regexp.QuoteMeta(v)
+	}
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(strings.Join(quoted, "|"))
+}
+
+// write emits the collected imports ahead of the type declaration.
+func (c *constraints) write(sb *strings.Builder) {
+	if len(c.imports) == 0 {
+		return
+	}
+	slices.Sort(c.imports)
+	for _, i := range c.imports {
+		sb.WriteString(fmt.Sprintf("import %s;\n", i))
+	}
+	sb.WriteString("\n")
+}
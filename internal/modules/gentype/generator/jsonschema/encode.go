@@ -0,0 +1,167 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// object is a JSON object that keeps keys in insertion order so generated
+// schemas list properties in column order.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object {
+	return &object{values: map[string]any{}}
+}
+
+func (o *object) set(key string, value any) *object {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+	return o
+}
+
+func indentation(level int) string {
+	return strings.Repeat("  ", level)
+}
+
+func quoteJSON(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func writeJSON(sb *strings.Builder, value any, level int) {
+	switch v := value.(type) {
+	case *object:
+		if len(v.keys) == 0 {
+			sb.WriteString("{}")
+			return
+		}
+		sb.WriteString("{\n")
+		for i, key := range v.keys {
+			sb.WriteString(indentation(level + 1))
+			sb.WriteString(quoteJSON(key))
+			sb.WriteString(": ")
+			writeJSON(sb, v.values[key], level+1)
+			if i < len(v.keys)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indentation(level))
+		sb.WriteString("}")
+	case []any:
+		if !hasObjects(v) {
+			sb.WriteString("[")
+			for i, item := range v {
+				if i > 0 {
+					sb.WriteString(", ")
+				}
+				writeJSON(sb, item, level)
+			}
+			sb.WriteString("]")
+			return
+		}
+		sb.WriteString("[\n")
+		for i, item := range v {
+			sb.WriteString(indentation(level + 1))
+			writeJSON(sb, item, level+1)
+			if i < len(v)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indentation(level))
+		sb.WriteString("]")
+	default:
+		writeScalar(sb, v, quoteJSON)
+	}
+}
+
+func writeYAML(sb *strings.Builder, obj *object, level int) {
+	for _, key := range obj.keys {
+		sb.WriteString(indentation(level))
+		sb.WriteString(quoteYAML(key))
+		sb.WriteString(":")
+
+		switch v := obj.values[key].(type) {
+		case *object:
+			if len(v.keys) == 0 {
+				sb.WriteString(" {}\n")
+				continue
+			}
+			sb.WriteString("\n")
+			writeYAML(sb, v, level+1)
+		case []any:
+			if !hasObjects(v) {
+				sb.WriteString(" [")
+				for i, item := range v {
+					if i > 0 {
+						sb.WriteString(", ")
+					}
+					writeScalar(sb, item, quoteJSON)
+				}
+				sb.WriteString("]\n")
+				continue
+			}
+			sb.WriteString("\n")
+			for _, item := range v {
+				nested, ok := item.(*object)
+				if !ok {
+					continue
+				}
+				var itemSb strings.Builder
+				writeYAML(&itemSb, nested, level+2)
+				sb.WriteString(indentation(level + 1))
+				sb.WriteString("- ")
+				sb.WriteString(strings.TrimPrefix(itemSb.String(), indentation(level+2)))
+			}
+		default:
+			sb.WriteString(" ")
+			writeScalar(sb, v, quoteYAML)
+			sb.WriteString("\n")
+		}
+	}
+}
+
+func writeScalar(sb *strings.Builder, value any, quote func(string) string) {
+	switch v := value.(type) {
+	case nil:
+		sb.WriteString("null")
+	case string:
+		sb.WriteString(quote(v))
+	case int:
+		sb.WriteString(strconv.Itoa(v))
+	case bool:
+		sb.WriteString(strconv.FormatBool(v))
+	}
+}
+
+func hasObjects(items []any) bool {
+	for _, item := range items {
+		if _, ok := item.(*object); ok {
+			return true
+		}
+	}
+	return false
+}
+
+var plainYAML = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$./ -]*$`)
+
+// quoteYAML leaves simple strings as plain scalars and falls back to JSON
+// quoting, which YAML accepts, for anything that could be misread.
+func quoteYAML(s string) string {
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~", "y", "n":
+		return quoteJSON(s)
+	}
+	if !plainYAML.MatchString(s) || strings.HasSuffix(s, " ") {
+		return quoteJSON(s)
+	}
+	return s
+}
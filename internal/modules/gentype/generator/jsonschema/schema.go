@@ -0,0 +1,391 @@
+package jsonschema
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JsonSchema writes a JSON Schema document with one $defs entry per table.
+// References between tables need the whole bundle, so entries are collected
+// by Generate and the document is written by Footer.
+type JsonSchema struct {
+	schemas *object
+}
+
+func (j *JsonSchema) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	opt, err := parseOptions(req)
+	if err != nil {
+		return "Invalid JSON Schema Options", err
+	}
+
+	schema, err := buildSchema(rows, req, dbType, opt, "#/$defs/")
+	if err != nil {
+		return "", err
+	}
+
+	if j.schemas == nil {
+		j.schemas = newObject()
+	}
+	j.schemas.set(schemaNameFor(req, tbN), schema)
+	return "", nil
+}
+
+func (j *JsonSchema) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	opt, err := parseOptions(req)
+	if err != nil {
+		return "Invalid JSON Schema Options", err
+	}
+
+	if j.schemas == nil {
+		j.schemas = newObject()
+	}
+
+	document := newObject().
+		set("$schema", schemaDialect).
+		set("$defs", j.schemas)
+	return writeDocument(document, opt), nil
+}
+
+// OpenApi writes an OpenAPI document holding the tables as component
+// schemas, collected the same way as JsonSchema.
+type OpenApi struct {
+	schemas *object
+}
+
+func (o *OpenApi) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	opt, err := parseOptions(req)
+	if err != nil {
+		return "Invalid OpenAPI Options", err
+	}
+
+	schema, err := buildSchema(rows, req, dbType, opt, "#/components/schemas/")
+	if err != nil {
+		return "", err
+	}
+
+	if o.schemas == nil {
+		o.schemas = newObject()
+	}
+	o.schemas.set(schemaNameFor(req, tbN), schema)
+	return "", nil
+}
+
+func (o *OpenApi) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	opt, err := parseOptions(req)
+	if err != nil {
+		return "Invalid OpenAPI Options", err
+	}
+
+	title := opt.Title
+	if title == "" {
+		title = "Generated Schemas"
+	}
+	version := opt.Version
+	if version == "" {
+		version = "1.0.0"
+	}
+
+	if o.schemas == nil {
+		o.schemas = newObject()
+	}
+
+	document := newObject().
+		set("openapi", "3.1.0").
+		set("info", newObject().set("title", title).set("version", version)).
+		set("components", newObject().set("schemas", o.schemas))
+	return writeDocument(document, opt), nil
+}
+
+func parseOptions(req domain.TypeRequest) (domain.JsonSchemaOptions, error) {
+	var opt domain.JsonSchemaOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return opt, fmt.Errorf("invalid JSON Schema options: %w", err)
+	}
+
+	switch strings.ToLower(opt.Format) {
+	case "", "json", "yaml", "yml":
+		return opt, nil
+	default:
+		return opt, fmt.Errorf("unsupported schema format: %s", opt.Format)
+	}
+}
+
+func isYAML(opt domain.JsonSchemaOptions) bool {
+	format := strings.ToLower(opt.Format)
+	return format == "yaml" || format == "yml"
+}
+
+func writeDocument(document *object, opt domain.JsonSchemaOptions) string {
+	var sb strings.Builder
+	if isYAML(opt) {
+		writeYAML(&sb, document, 0)
+		return sb.String()
+	}
+	writeJSON(&sb, document, 0)
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// buildSchema renders one table as an object schema.
+func buildSchema(rows *sql.Rows, req domain.TypeRequest, dbType string,
+	opt domain.JsonSchemaOptions, refPrefix string) (*object, error) {
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := newObject()
+	schema.set("type", "object")
+
+	properties := newObject()
+	var required []any
+
+	for _, col := range columns {
+		propertyName := common.ToCamelCase(col.ColumnName)
+		nullable := strings.EqualFold(col.IsNullable, "YES")
+
+		properties.set(propertyName, buildProperty(col, req, dbType, opt, refPrefix, nullable))
+
+		if !nullable {
+			required = append(required, propertyName)
+		}
+	}
+
+	schema.set("properties", properties)
+	if len(required) > 0 {
+		schema.set("required", required)
+	}
+	if opt.Strict {
+		schema.set("additionalProperties", false)
+	}
+
+	return schema, nil
+}
+
+func buildProperty(col domain.SqlData, req domain.TypeRequest, dbType string,
+	opt domain.JsonSchemaOptions, refPrefix string, nullable bool) *object {
+
+	property := newObject()
+
+	// Foreign keys point at the referenced column when that table is part of the bundle.
+	if col.ReferencedTable.Valid && col.ReferencedColumn.Valid && hasTable(req, col.ReferencedTable.String) {
+		ref := newObject().set("$ref", fmt.Sprintf(
+			"%s%s/properties/%s",
+			refPrefix,
+			schemaNameFor(req, col.ReferencedTable.String),
+			common.ToCamelCase(col.ReferencedColumn.String),
+		))
+		if nullable {
+			property.set("anyOf", []any{ref, newObject().set("type", "null")})
+		} else {
+			property = ref
+		}
+		writeDescription(property, col, opt)
+		return property
+	}
+
+	var jt jsonType
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		jt = mapMySQLToJsonType(col.DataType)
+	case "postgres":
+		jt = mapPostgresToJsonType(col.DataType)
+	case "mssql":
+		jt = mapMSSQLToJsonType(col.DataType)
+	default:
+		jt = jsonType{Type: "string"}
+	}
+
+	enumValues := common.EnumValues(col)
+	if len(enumValues) > 0 {
+		jt = jsonType{Type: "string"}
+	}
+
+	if jt.Type == "string" && jt.Format == "" && strings.Contains(strings.ToLower(col.ColumnName), "email") {
+		jt.Format = "email"
+	}
+
+	if nullable {
+		property.set("type", []any{jt.Type, "null"})
+	} else {
+		property.set("type", jt.Type)
+	}
+
+	if jt.Items != "" {
+		items := newObject().set("type", jt.Items)
+		if jt.Format != "" {
+			items.set("format", jt.Format)
+		}
+		property.set("items", items)
+	} else if jt.Format == "binary" {
+		property.set("contentEncoding", "base64")
+	} else if jt.Format != "" {
+		property.set("format", jt.Format)
+	}
+
+	if jt.Type == "string" && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		property.set("maxLength", int(col.CharacterMaximumLength.Int16))
+	}
+
+	if len(enumValues) > 0 {
+		var values []any
+		for _, v := range enumValues {
+			values = append(values, v)
+		}
+		if nullable {
+			values = append(values, nil)
+		}
+		property.set("enum", values)
+	}
+
+	writeDescription(property, col, opt)
+	return property
+}
+
+func writeDescription(property *object, col domain.SqlData, opt domain.JsonSchemaOptions) {
+	if opt.Descriptions && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+		property.set("description", strings.TrimSpace(col.ColumnComment.String))
+	}
+}
+
+func schemaNameFor(req domain.TypeRequest, tbN string) string {
+	return req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+}
+
+func hasTable(req domain.TypeRequest, tbN string) bool {
+	for _, name := range req.TableNames {
+		if strings.EqualFold(name, tbN) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonType is a JSON Schema type with an optional format. Items is set for
+// array columns and names the element type.
+type jsonType struct {
+	Type   string
+	Format string
+	Items  string
+}
+
+func mapMySQLToJsonType(mysqlType string) jsonType {
+	switch strings.ToLower(mysqlType) {
+
+	case "int", "integer", "mediumint", "bigint", "smallint", "tinyint", "year":
+		return jsonType{Type: "integer"}
+
+	case "decimal", "numeric", "float", "double":
+		return jsonType{Type: "number"}
+
+	case "varchar", "char", "text", "longtext", "mediumtext", "tinytext", "enum", "set":
+		return jsonType{Type: "string"}
+
+	case "date":
+		return jsonType{Type: "string", Format: "date"}
+	case "datetime", "timestamp":
+		return jsonType{Type: "string", Format: "date-time"}
+	case "time":
+		return jsonType{Type: "string", Format: "time"}
+
+	case "boolean", "bit":
+		return jsonType{Type: "boolean"}
+
+	case "json":
+		return jsonType{Type: "object"}
+
+	case "blob", "longblob", "mediumblob", "tinyblob", "binary", "varbinary":
+		return jsonType{Type: "string", Format: "binary"}
+
+	default:
+		return jsonType{Type: "string"}
+	}
+}
+
+func mapPostgresToJsonType(pgType string) jsonType {
+	switch strings.ToLower(pgType) {
+
+	case "smallint", "int2", "integer", "int", "int4", "serial",
+		"bigint", "int8", "bigserial":
+		return jsonType{Type: "integer"}
+
+	case "decimal", "numeric", "money", "real", "float4", "double precision", "float8":
+		return jsonType{Type: "number"}
+
+	case "varchar", "character varying", "char", "character", "bpchar", "text", "citext", "xml":
+		return jsonType{Type: "string"}
+
+	case "inet":
+		return jsonType{Type: "string", Format: "ipv4"}
+
+	case "date":
+		return jsonType{Type: "string", Format: "date"}
+	case "time", "time without time zone":
+		return jsonType{Type: "string", Format: "time"}
+	case "timestamp", "timestamp without time zone", "timestamptz", "timestamp with time zone":
+		return jsonType{Type: "string", Format: "date-time"}
+
+	case "boolean", "bool":
+		return jsonType{Type: "boolean"}
+
+	case "uuid":
+		return jsonType{Type: "string", Format: "uuid"}
+
+	case "json", "jsonb":
+		return jsonType{Type: "object"}
+
+	case "bytea":
+		return jsonType{Type: "string", Format: "binary"}
+
+	case "_int4", "integer[]", "_int8", "bigint[]":
+		return jsonType{Type: "array", Items: "integer"}
+	case "_text", "text[]":
+		return jsonType{Type: "array", Items: "string"}
+	case "_uuid", "uuid[]":
+		return jsonType{Type: "array", Items: "string", Format: "uuid"}
+
+	default:
+		return jsonType{Type: "string"}
+	}
+}
+
+func mapMSSQLToJsonType(mssqlType string) jsonType {
+	switch strings.ToLower(mssqlType) {
+
+	case "int", "bigint", "smallint", "tinyint":
+		return jsonType{Type: "integer"}
+
+	case "decimal", "numeric", "money", "smallmoney", "float", "real":
+		return jsonType{Type: "number"}
+
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext", "xml":
+		return jsonType{Type: "string"}
+
+	case "date":
+		return jsonType{Type: "string", Format: "date"}
+	case "time":
+		return jsonType{Type: "string", Format: "time"}
+	case "datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return jsonType{Type: "string", Format: "date-time"}
+
+	case "bit":
+		return jsonType{Type: "boolean"}
+
+	case "uniqueidentifier":
+		return jsonType{Type: "string", Format: "uuid"}
+
+	case "binary", "varbinary", "image", "rowversion", "timestamp":
+		return jsonType{Type: "string", Format: "binary"}
+
+	default:
+		return jsonType{Type: "string"}
+	}
+}
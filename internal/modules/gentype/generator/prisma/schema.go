@@ -0,0 +1,647 @@
+package prisma
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Schema writes a single schema.prisma. Relations need a field on both
+// models, so tables are collected by Generate and rendered by Footer once
+// every table in the request is known.
+type Schema struct {
+	models []*model
+	enums  []*enum
+}
+
+type model struct {
+	Name   string
+	Table  string
+	Fields []*field
+	Ids    []*field
+}
+
+type field struct {
+	Name          string
+	Column        string
+	Type          string
+	Native        string
+	Optional      bool
+	List          bool
+	Id            bool
+	Unique        bool
+	AutoIncrement bool
+	Comment       string
+	RefTable      string
+	RefColumn     string
+	Attributes    []string
+}
+
+type enum struct {
+	Name   string
+	DbName string
+	Values []string
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+var invalidChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func (s *Schema) Header(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	var provider string
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		provider = "mysql"
+	case "postgres":
+		provider = "postgresql"
+	case "mssql":
+		provider = "sqlserver"
+	default:
+		return "", fmt.Errorf("unsupported prisma provider: %s", dbType)
+	}
+
+	sb.WriteString("generator client {\n")
+	sb.WriteString("  provider = \"prisma-client-js\"\n")
+	sb.WriteString("}\n\n")
+	sb.WriteString("datasource db {\n")
+	sb.WriteString(fmt.Sprintf("  provider = \"%s\"\n", provider))
+	sb.WriteString("  url      = env(\"DATABASE_URL\")\n")
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+func (s *Schema) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var opt domain.PrismaOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Prisma Options", fmt.Errorf("invalid Prisma options: %w", err)
+	}
+
+	m := &model{
+		Name:  req.Prefix + common.ToPascalCase(tbN) + req.Suffix,
+		Table: tbN,
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	for _, col := range columns {
+		f := &field{
+			Name:          common.ToCamelCase(col.ColumnName),
+			Column:        col.ColumnName,
+			Optional:      strings.EqualFold(col.IsNullable, "YES"),
+			Id:            strings.Contains(col.ColumnKey, "PRI"),
+			Unique:        strings.Contains(col.ColumnKey, "UNI"),
+			AutoIncrement: col.IsIdentity == "YES",
+		}
+
+		if values := common.EnumValues(col); len(values) > 0 {
+			f.Type = s.enumFor(m, col, values, dbType)
+		} else {
+			switch strings.ToLower(dbType) {
+			case "mysql":
+				f.Type, f.Native = mapMySQLToPrisma(col)
+			case "postgres":
+				f.Type, f.Native = mapPostgresToPrisma(col)
+			case "mssql":
+				f.Type, f.Native = mapMSSQLToPrisma(col)
+			default:
+				f.Type = "String"
+			}
+		}
+
+		// Prisma lists cannot be optional.
+		if strings.HasSuffix(f.Type, "[]") {
+			f.List = true
+			f.Optional = false
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			f.Comment = strings.TrimSpace(col.ColumnComment.String)
+		}
+
+		if col.ReferencedTable.Valid && col.ReferencedColumn.Valid {
+			f.RefTable = col.ReferencedTable.String
+			f.RefColumn = col.ReferencedColumn.String
+		}
+
+		if f.Id {
+			m.Ids = append(m.Ids, f)
+		}
+		m.Fields = append(m.Fields, f)
+	}
+
+	s.models = append(s.models, m)
+
+	return "", nil
+}
+
+func (s *Schema) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	s.resolveRelations()
+
+	for _, e := range s.enums {
+		sb.WriteString("\n")
+		writeEnum(&sb, e)
+	}
+
+	for _, m := range s.models {
+		sb.WriteString("\n")
+		writeModel(&sb, m)
+	}
+
+	return sb.String(), nil
+}
+
+// enumFor registers the enum backing a column. Postgres enums are named
+// types shared between tables while MySQL declares them per column.
+func (s *Schema) enumFor(m *model, col domain.SqlData, values []string, dbType string) string {
+	name := m.Name + common.ToPascalCase(col.ColumnName)
+	dbName := ""
+	if strings.EqualFold(dbType, "postgres") {
+		name = common.ToPascalCase(col.DataType)
+		dbName = col.DataType
+	}
+
+	for _, e := range s.enums {
+		if e.Name == name {
+			return name
+		}
+	}
+
+	s.enums = append(s.enums, &enum{Name: name, DbName: dbName, Values: values})
+	return name
+}
+
+func (s *Schema) findModel(table string) *model {
+	for _, m := range s.models {
+		if strings.EqualFold(m.Table, table) {
+			return m
+		}
+	}
+	return nil
+}
+
+// resolveRelations adds a relation field for each foreign key whose target is
+// part of the bundle, plus the opposite field on the referenced model.
+func (s *Schema) resolveRelations() {
+	type relation struct {
+		from   *model
+		fk     *field
+		target *model
+		ref    *field
+	}
+
+	var relations []relation
+	counts := map[string]int{}
+
+	for _, m := range s.models {
+		for _, f := range m.Fields {
+			if f.RefTable == "" {
+				continue
+			}
+			target := s.findModel(f.RefTable)
+			if target == nil {
+				continue
+			}
+			ref := findField(target, f.RefColumn)
+			// Prisma only relates to fields that are unique on the target.
+			if ref == nil || !(ref.Unique || (ref.Id && len(target.Ids) == 1)) {
+				continue
+			}
+			relations = append(relations, relation{from: m, fk: f, target: target, ref: ref})
+			counts[m.Name+"."+target.Name]++
+		}
+	}
+
+	for _, r := range relations {
+		fieldName := strings.TrimSuffix(strings.TrimSuffix(r.fk.Name, "Id"), "ID")
+		if fieldName == "" || fieldName == r.fk.Name {
+			fieldName = lowerFirst(r.target.Name)
+		}
+		fieldName = uniqueName(r.from, fieldName)
+
+		// Several relations between the same models, or a model relating to
+		// itself, must be told apart by name.
+		relationName := ""
+		if counts[r.from.Name+"."+r.target.Name] > 1 || r.from == r.target {
+			relationName = r.from.Name + upperFirst(fieldName)
+		}
+
+		args := fmt.Sprintf("fields: [%s], references: [%s]", r.fk.Name, r.ref.Name)
+		if relationName != "" {
+			args = fmt.Sprintf("%q, %s", relationName, args)
+		}
+
+		r.from.Fields = append(r.from.Fields, &field{
+			Name:       fieldName,
+			Type:       r.target.Name,
+			Optional:   r.fk.Optional,
+			Attributes: []string{fmt.Sprintf("@relation(%s)", args)},
+		})
+
+		// A unique foreign key makes the opposite side singular.
+		oneToOne := r.fk.Unique || (r.fk.Id && len(r.from.Ids) == 1)
+
+		backName := lowerFirst(r.from.Name)
+		if relationName != "" {
+			backName += upperFirst(fieldName)
+		}
+		back := &field{
+			Name: uniqueName(r.target, backName),
+			Type: r.from.Name,
+		}
+		if oneToOne {
+			back.Optional = true
+		} else {
+			back.Type += "[]"
+			back.List = true
+		}
+		if relationName != "" {
+			back.Attributes = []string{fmt.Sprintf("@relation(%q)", relationName)}
+		}
+		r.target.Fields = append(r.target.Fields, back)
+	}
+}
+
+func findField(m *model, column string) *field {
+	for _, f := range m.Fields {
+		if strings.EqualFold(f.Column, column) {
+			return f
+		}
+	}
+	return nil
+}
+
+func uniqueName(m *model, name string) string {
+	candidate := name
+	for i := 2; findFieldByName(m, candidate) != nil; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	return candidate
+}
+
+func findFieldByName(m *model, name string) *field {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func writeEnum(sb *strings.Builder, e *enum) {
+	sb.WriteString(fmt.Sprintf("enum %s {\n", e.Name))
+	for _, value := range e.Values {
+		if identifier.MatchString(value) {
+			sb.WriteString(fmt.Sprintf("  %s\n", value))
+			continue
+		}
+		name := invalidChars.ReplaceAllString(value, "_")
+		if !identifier.MatchString(name) {
+			name = "V" + name
+		}
+		sb.WriteString(fmt.Sprintf("  %s @map(%q)\n", name, value))
+	}
+	if e.DbName != "" && e.DbName != e.Name {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("  @@map(%q)\n", e.DbName))
+	}
+	sb.WriteString("}\n")
+}
+
+func writeModel(sb *strings.Builder, m *model) {
+	nameWidth, typeWidth := 0, 0
+	for _, f := range m.Fields {
+		nameWidth = max(nameWidth, len(f.Name))
+		typeWidth = max(typeWidth, len(fieldType(f)))
+	}
+
+	sb.WriteString(fmt.Sprintf("model %s {\n", m.Name))
+	for _, f := range m.Fields {
+		if f.Comment != "" {
+			sb.WriteString(fmt.Sprintf("  /// %s\n", f.Comment))
+		}
+
+		var attributes []string
+		if f.Id && len(m.Ids) == 1 {
+			attributes = append(attributes, "@id")
+		}
+		if f.AutoIncrement {
+			attributes = append(attributes, "@default(autoincrement())")
+		}
+		if f.Unique && !f.Id {
+			attributes = append(attributes, "@unique")
+		}
+		if f.Column != "" && f.Column != f.Name {
+			attributes = append(attributes, fmt.Sprintf("@map(%q)", f.Column))
+		}
+		if f.Native != "" {
+			attributes = append(attributes, f.Native)
+		}
+		attributes = append(attributes, f.Attributes...)
+
+		line := fmt.Sprintf("  %-*s %-*s %s", nameWidth, f.Name, typeWidth, fieldType(f), strings.Join(attributes, " "))
+		sb.WriteString(strings.TrimRight(line, " "))
+		sb.WriteString("\n")
+	}
+
+	var blockAttributes []string
+	if len(m.Ids) > 1 {
+		var names []string
+		for _, f := range m.Ids {
+			names = append(names, f.Name)
+		}
+		blockAttributes = append(blockAttributes, fmt.Sprintf("@@id([%s])", strings.Join(names, ", ")))
+	}
+	if m.Table != m.Name {
+		blockAttributes = append(blockAttributes, fmt.Sprintf("@@map(%q)", m.Table))
+	}
+	if len(blockAttributes) > 0 {
+		sb.WriteString("\n")
+		for _, attribute := range blockAttributes {
+			sb.WriteString(fmt.Sprintf("  %s\n", attribute))
+		}
+	}
+	sb.WriteString("}\n")
+}
+
+func fieldType(f *field) string {
+	if f.Optional && !f.List {
+		return f.Type + "?"
+	}
+	return f.Type
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func length(col domain.SqlData) (int, bool) {
+	if !col.CharacterMaximumLength.Valid {
+		return 0, false
+	}
+	return int(col.CharacterMaximumLength.Int16), true
+}
+
+func sized(native string, col domain.SqlData) string {
+	if n, ok := length(col); ok && n > 0 {
+		return fmt.Sprintf("@db.%s(%d)", native, n)
+	}
+	return "@db." + native
+}
+
+// sizedOrMax handles SQL Server's (max) lengths, reported as -1.
+func sizedOrMax(native string, col domain.SqlData) string {
+	if n, ok := length(col); ok && n < 0 {
+		return fmt.Sprintf("@db.%s(Max)", native)
+	}
+	return sized(native, col)
+}
+
+func decimal(col domain.SqlData) string {
+	if !col.NumericPrecision.Valid || col.NumericPrecision.Int16 <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("@db.Decimal(%d, %d)", col.NumericPrecision.Int16, col.NumericScale.Int16)
+}
+
+func unsupported(dataType string) string {
+	return fmt.Sprintf("Unsupported(%q)", dataType)
+}
+
+func mapMySQLToPrisma(col domain.SqlData) (string, string) {
+	switch strings.ToLower(col.DataType) {
+
+	case "tinyint":
+		return "Int", "@db.TinyInt"
+	case "smallint":
+		return "Int", "@db.SmallInt"
+	case "mediumint":
+		return "Int", "@db.MediumInt"
+	case "int", "integer":
+		return "Int", ""
+	case "bigint":
+		return "BigInt", ""
+	case "year":
+		return "Int", "@db.Year"
+
+	case "decimal", "numeric":
+		return "Decimal", decimal(col)
+	case "float":
+		return "Float", "@db.Float"
+	case "double":
+		return "Float", ""
+
+	case "varchar":
+		return "String", sized("VarChar", col)
+	case "char":
+		return "String", sized("Char", col)
+	case "tinytext":
+		return "String", "@db.TinyText"
+	case "text":
+		return "String", "@db.Text"
+	case "mediumtext":
+		return "String", "@db.MediumText"
+	case "longtext":
+		return "String", "@db.LongText"
+	case "enum", "set":
+		return "String", ""
+
+	case "json":
+		return "Json", ""
+
+	case "date":
+		return "DateTime", "@db.Date"
+	case "datetime":
+		return "DateTime", "@db.DateTime(0)"
+	case "timestamp":
+		return "DateTime", "@db.Timestamp(0)"
+	case "time":
+		return "DateTime", "@db.Time(0)"
+
+	case "boolean", "bool":
+		return "Boolean", ""
+	case "bit":
+		return "Boolean", "@db.Bit(1)"
+
+	case "tinyblob":
+		return "Bytes", "@db.TinyBlob"
+	case "blob":
+		return "Bytes", "@db.Blob"
+	case "mediumblob":
+		return "Bytes", "@db.MediumBlob"
+	case "longblob":
+		return "Bytes", "@db.LongBlob"
+	case "binary":
+		return "Bytes", sized("Binary", col)
+	case "varbinary":
+		return "Bytes", sized("VarBinary", col)
+
+	default:
+		return unsupported(col.DataType), ""
+	}
+}
+
+func mapPostgresToPrisma(col domain.SqlData) (string, string) {
+	dataType := strings.ToLower(col.DataType)
+
+	// Array columns are reported by their element udt name with a leading underscore.
+	if strings.HasPrefix(dataType, "_") {
+		element := col
+		element.DataType = strings.TrimPrefix(dataType, "_")
+		prismaType, native := mapPostgresToPrisma(element)
+		if strings.HasPrefix(prismaType, "Unsupported") {
+			return unsupported(col.DataType), ""
+		}
+		return prismaType + "[]", native
+	}
+
+	switch dataType {
+
+	case "int2", "smallint":
+		return "Int", "@db.SmallInt"
+	case "int4", "integer", "int", "serial":
+		return "Int", ""
+	case "int8", "bigint", "bigserial":
+		return "BigInt", ""
+	case "oid":
+		return "Int", "@db.Oid"
+
+	case "numeric", "decimal":
+		return "Decimal", decimal(col)
+	case "money":
+		return "Decimal", "@db.Money"
+	case "float4", "real":
+		return "Float", "@db.Real"
+	case "float8", "double precision":
+		return "Float", ""
+
+	case "varchar", "character varying":
+		return "String", sized("VarChar", col)
+	case "bpchar", "char", "character":
+		return "String", sized("Char", col)
+	case "text":
+		return "String", ""
+	case "citext":
+		return "String", "@db.Citext"
+	case "inet":
+		return "String", "@db.Inet"
+	case "xml":
+		return "String", "@db.Xml"
+	case "uuid":
+		return "String", "@db.Uuid"
+
+	case "bool", "boolean":
+		return "Boolean", ""
+
+	case "date":
+		return "DateTime", "@db.Date"
+	case "timestamp":
+		return "DateTime", "@db.Timestamp(6)"
+	case "timestamptz":
+		return "DateTime", "@db.Timestamptz(6)"
+	case "time":
+		return "DateTime", "@db.Time(6)"
+	case "timetz":
+		return "DateTime", "@db.Timetz(6)"
+
+	case "json":
+		return "Json", "@db.Json"
+	case "jsonb":
+		return "Json", ""
+
+	case "bytea":
+		return "Bytes", ""
+
+	default:
+		return unsupported(col.DataType), ""
+	}
+}
+
+func mapMSSQLToPrisma(col domain.SqlData) (string, string) {
+	switch strings.ToLower(col.DataType) {
+
+	case "tinyint":
+		return "Int", "@db.TinyInt"
+	case "smallint":
+		return "Int", "@db.SmallInt"
+	case "int":
+		return "Int", ""
+	case "bigint":
+		return "BigInt", ""
+
+	case "decimal", "numeric":
+		return "Decimal", decimal(col)
+	case "money":
+		return "Decimal", "@db.Money"
+	case "smallmoney":
+		return "Decimal", "@db.SmallMoney"
+	case "float":
+		return "Float", ""
+	case "real":
+		return "Float", "@db.Real"
+
+	case "char":
+		return "String", sized("Char", col)
+	case "nchar":
+		return "String", sized("NChar", col)
+	case "varchar":
+		return "String", sizedOrMax("VarChar", col)
+	case "nvarchar":
+		return "String", sizedOrMax("NVarChar", col)
+	case "text":
+		return "String", "@db.Text"
+	case "ntext":
+		return "String", "@db.NText"
+	case "xml":
+		return "String", "@db.Xml"
+	case "uniqueidentifier":
+		return "String", "@db.UniqueIdentifier"
+
+	case "bit":
+		return "Boolean", ""
+
+	case "date":
+		return "DateTime", "@db.Date"
+	case "time":
+		return "DateTime", "@db.Time"
+	case "datetime":
+		return "DateTime", "@db.DateTime"
+	case "datetime2":
+		return "DateTime", ""
+	case "smalldatetime":
+		return "DateTime", "@db.SmallDateTime"
+	case "datetimeoffset":
+		return "DateTime", "@db.DateTimeOffset"
+
+	case "binary":
+		return "Bytes", sized("Binary", col)
+	case "varbinary":
+		return "Bytes", sizedOrMax("VarBinary", col)
+	case "image":
+		return "Bytes", "@db.Image"
+
+	default:
+		return unsupported(col.DataType), ""
+	}
+}
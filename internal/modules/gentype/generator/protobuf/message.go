@@ -0,0 +1,270 @@
+package protobuf
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Message writes proto3 messages. The imports depend on the fields of every
+// table, so the file is rendered by Footer.
+type Message struct {
+	messages []string
+	imports  []string
+}
+
+func (m *Message) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	var opt domain.ProtobufOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Protobuf Options", fmt.Errorf("invalid Protobuf options: %w", err)
+	}
+
+	sb.WriteString("syntax = \"proto3\";\n\n")
+
+	if opt.Package != "" {
+		sb.WriteString(fmt.Sprintf("package %s;\n\n", opt.Package))
+	}
+
+	if len(m.imports) > 0 {
+		slices.Sort(m.imports)
+		for _, i := range m.imports {
+			sb.WriteString(fmt.Sprintf("import \"%s\";\n", i))
+		}
+		sb.WriteString("\n")
+	}
+
+	if opt.GoPackage != "" {
+		sb.WriteString(fmt.Sprintf("option go_package = \"%s\";\n", opt.GoPackage))
+	}
+	if opt.JavaPackage != "" {
+		sb.WriteString(fmt.Sprintf("option java_package = \"%s\";\n", opt.JavaPackage))
+		sb.WriteString("option java_multiple_files = true;\n")
+	}
+	if opt.GoPackage != "" || opt.JavaPackage != "" {
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(strings.Join(m.messages, "\n"))
+
+	return sb.String(), nil
+}
+
+// use records the import that declares a google.protobuf well-known type.
+func (m *Message) use(protoType string) {
+	var path string
+	switch {
+	case protoType == "google.protobuf.Timestamp":
+		path = "google/protobuf/timestamp.proto"
+	case strings.HasPrefix(protoType, "google.protobuf.") && strings.HasSuffix(protoType, "Value"):
+		path = "google/protobuf/wrappers.proto"
+	default:
+		return
+	}
+	if !slices.Contains(m.imports, path) {
+		m.imports = append(m.imports, path)
+	}
+}
+
+func (m *Message) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	messageName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.ProtobufOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Protobuf Options", fmt.Errorf("invalid Protobuf options: %w", err)
+	}
+
+	sb.WriteString(fmt.Sprintf("message %s {\n", messageName))
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	for _, col := range columns {
+		var protoType string
+		switch strings.ToLower(dbType) {
+		case "mysql":
+			protoType = mapMySQLToProtoType(col.DataType)
+		case "postgres":
+			protoType = mapPostgresToProtoType(col.DataType)
+		case "mssql":
+			protoType = mapMSSQLToProtoType(col.DataType)
+		default:
+			protoType = "string"
+		}
+
+		if strings.EqualFold(col.IsNullable, "YES") {
+			protoType = nullableProtoType(protoType, opt.Wrappers)
+		}
+		m.use(strings.TrimPrefix(protoType, "repeated "))
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			for _, line := range strings.Split(strings.TrimSpace(col.ColumnComment.String), "\n") {
+				sb.WriteString(fmt.Sprintf("  // %s\n", strings.TrimSpace(line)))
+			}
+		}
+
+		// Field numbers follow the column ordinal so regenerating keeps the wire format stable.
+		sb.WriteString(fmt.Sprintf(
+			"  %s %s = %d;\n",
+			protoType,
+			common.ToSnakeCase(col.ColumnName),
+			col.Ordinal,
+		))
+
+		if opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	m.messages = append(m.messages, sb.String())
+	return "", nil
+}
+
+// nullableProtoType gives a scalar field presence, either through a
+// google.protobuf wrapper or the proto3 optional keyword. Message and
+// repeated fields are returned unchanged.
+func nullableProtoType(protoType string, wrappers bool) string {
+	if strings.HasPrefix(protoType, "repeated ") || strings.HasPrefix(protoType, "google.protobuf.") {
+		return protoType
+	}
+
+	if !wrappers {
+		return "optional " + protoType
+	}
+
+	switch protoType {
+	case "int32":
+		return "google.protobuf.Int32Value"
+	case "int64":
+		return "google.protobuf.Int64Value"
+	case "float":
+		return "google.protobuf.FloatValue"
+	case "double":
+		return "google.protobuf.DoubleValue"
+	case "bool":
+		return "google.protobuf.BoolValue"
+	case "bytes":
+		return "google.protobuf.BytesValue"
+	default:
+		return "google.protobuf.StringValue"
+	}
+}
+
+func mapMySQLToProtoType(mysqlType string) string {
+	switch strings.ToLower(mysqlType) {
+
+	case "int", "integer", "mediumint", "smallint", "tinyint", "year":
+		return "int32"
+	case "bigint":
+		return "int64"
+
+	case "decimal", "numeric":
+		return "string"
+	case "float":
+		return "float"
+	case "double":
+		return "double"
+
+	case "varchar", "char", "text", "longtext", "mediumtext", "tinytext", "enum", "json", "time":
+		return "string"
+
+	case "date", "datetime", "timestamp":
+		return "google.protobuf.Timestamp"
+
+	case "boolean", "bit":
+		return "bool"
+
+	case "blob", "longblob", "mediumblob", "tinyblob", "binary", "varbinary":
+		return "bytes"
+
+	default:
+		return "string"
+	}
+}
+
+func mapPostgresToProtoType(pgType string) string {
+	switch strings.ToLower(pgType) {
+
+	case "smallint", "int2", "integer", "int", "int4", "serial":
+		return "int32"
+	case "bigint", "int8", "bigserial":
+		return "int64"
+
+	case "decimal", "numeric", "money":
+		return "string"
+	case "real", "float4":
+		return "float"
+	case "double precision", "float8":
+		return "double"
+
+	case "varchar", "character varying", "char", "character", "bpchar", "text", "citext",
+		"uuid", "json", "jsonb", "xml", "inet", "cidr", "macaddr",
+		"time", "time without time zone":
+		return "string"
+
+	case "date", "timestamp", "timestamp without time zone",
+		"timestamptz", "timestamp with time zone":
+		return "google.protobuf.Timestamp"
+
+	case "boolean", "bool":
+		return "bool"
+
+	case "bytea":
+		return "bytes"
+
+	case "_int4", "integer[]":
+		return "repeated int32"
+	case "_int8", "bigint[]":
+		return "repeated int64"
+	case "_text", "text[]", "_uuid", "uuid[]":
+		return "repeated string"
+
+	default:
+		return "string"
+	}
+}
+
+func mapMSSQLToProtoType(mssqlType string) string {
+	switch strings.ToLower(mssqlType) {
+
+	case "int", "smallint", "tinyint":
+		return "int32"
+	case "bigint":
+		return "int64"
+
+	case "decimal", "numeric", "money", "smallmoney":
+		return "string"
+	case "real":
+		return "float"
+	case "float":
+		return "double"
+
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext", "xml",
+		"uniqueidentifier", "time":
+		return "string"
+
+	case "date", "datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return "google.protobuf.Timestamp"
+
+	case "bit":
+		return "bool"
+
+	case "binary", "varbinary", "image", "rowversion", "timestamp":
+		return "bytes"
+
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,107 @@
+package python
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Attrs writes attrs classes, validating character columns against their
+// declared length.
+type Attrs struct{}
+
+func (d *Attrs) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.PythonAttrsOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Python Attrs Options", fmt.Errorf("invalid python attrs options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var imports pyImports
+	imports.module("attrs")
+
+	var fields strings.Builder
+	var hasDefault []bool
+
+	for _, col := range columns {
+		fieldName := attributeName(col.ColumnName)
+		pyType := pythonType(&imports, dbType, col)
+
+		var validator string
+		if n := maxLength(col); n > 0 && pyType == "str" {
+			validator = fmt.Sprintf("attrs.validators.max_len(%d)", n)
+		}
+
+		isOpt := opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes"
+		if isOpt {
+			imports.from("typing", "Optional")
+			pyType = fmt.Sprintf("Optional[%s]", pyType)
+			if validator != "" {
+				validator = fmt.Sprintf("attrs.validators.optional(%s)", validator)
+			}
+		}
+		hasDefault = append(hasDefault, isOpt)
+
+		var args []string
+		if isOpt {
+			args = append(args, "default=None")
+		}
+		if validator != "" {
+			args = append(args, "validator="+validator)
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			fields.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
+		}
+
+		line := fmt.Sprintf("    %s: %s", fieldName, pyType)
+		switch {
+		case validator != "":
+			line += fmt.Sprintf(" = attrs.field(%s)", strings.Join(args, ", "))
+		case isOpt:
+			line += " = None"
+		}
+		fields.WriteString(line + "\n")
+
+		if opt.ExtraSpacing {
+			fields.WriteString("\n")
+		}
+	}
+
+	decorator := "@attrs.define"
+	if opt.Frozen {
+		decorator = "@attrs.frozen"
+	}
+	if needsKwOnly(hasDefault) {
+		decorator += "(kw_only=True)"
+	}
+
+	var sb strings.Builder
+	imports.write(&sb)
+
+	sb.WriteString(decorator + "\n")
+	sb.WriteString(fmt.Sprintf("class %s:\n", className))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s class\"\"\"\n\n", className))
+	}
+	if len(columns) == 0 {
+		sb.WriteString("    pass\n")
+	}
+	sb.WriteString(fields.String())
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
@@ -72,42 +72,26 @@ func (d *Dto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbTyp
 
 	hasField := false
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	for _, col := range columns {
 		hasField = true
 
-		pyType := mapDBToPythonType(dbType, dataType)
+		pyType := mapDBToPythonType(dbType, col.DataType)
 
-		fieldName := common.ToSnakeCase(columnName)
+		fieldName := common.ToSnakeCase(col.ColumnName)
 
 		// Optional handling
-		if opt.OptionalFields || strings.ToLower(isNullable) == "yes" {
+		if opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes" {
 			pyType = fmt.Sprintf("Optional[%s]", pyType)
 		}
 
 		// Comment
-		if opt.Comments && columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
-			sb.WriteString(fmt.Sprintf("    # %s\n", columnComment.String))
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
 		}
 
 		// Default values
@@ -42,40 +42,23 @@ func (d *DataClass) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string,
 
 	var fields []Field
 
-	for rows.Next() {
-
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
-		fieldName := common.ToSnakeCase(columnName)
-		pyType := mapDBToPythonType(dbType, dataType)
+	for _, col := range columns {
+		fieldName := common.ToSnakeCase(col.ColumnName)
+		pyType := mapDBToPythonType(dbType, col.DataType)
 
-		isOpt := opt.OptionalFields || strings.ToLower(isNullable) == "yes"
+		isOpt := opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes"
 		if isOpt {
 			pyType = fmt.Sprintf("Optional[%s]", pyType)
 		}
 
 		comment := ""
-		if columnComment.Valid {
-			comment = columnComment.String
+		if col.ColumnComment.Valid {
+			comment = col.ColumnComment.String
 		}
 
 		fields = append(fields, Field{
@@ -0,0 +1,293 @@
+package python
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Django writes Django models. Foreign keys to tables in the same request
+// become ForeignKey fields, so models are rendered by Footer.
+type Django struct {
+	ormModels
+	django domain.PythonDjangoOptions
+}
+
+func (d *Django) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	if err := json.Unmarshal(req.Options, &d.django); err != nil {
+		return "Invalid Python Django Options", fmt.Errorf("invalid python django options: %w", err)
+	}
+	if d.django.OnDelete != "" {
+		onDelete := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToUpper(strings.TrimSpace(d.django.OnDelete)))
+		if !slices.Contains(djangoOnDelete, onDelete) {
+			return "Invalid Python Django Options", fmt.Errorf("invalid python django options: unknown onDelete %q", d.django.OnDelete)
+		}
+		d.django.OnDelete = onDelete
+	}
+	return d.collect(rows, req, tbN, dbType)
+}
+
+func (d *Django) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	d.resolveRelations()
+	d.imports.from("django.db", "models")
+
+	var body strings.Builder
+	for i, m := range d.models {
+		if i > 0 {
+			body.WriteString("\n\n")
+		}
+		d.writeModel(&body, m)
+	}
+
+	var sb strings.Builder
+	d.imports.write(&sb)
+	sb.WriteString(body.String())
+
+	return sb.String(), nil
+}
+
+func (d *Django) writeModel(sb *strings.Builder, m *ormModel) {
+	opt := d.django
+
+	sb.WriteString(fmt.Sprintf("class %s(models.Model):\n", m.ClassName))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s model\"\"\"\n\n", m.ClassName))
+	}
+
+	switch {
+	case len(m.Keys) == 0:
+		sb.WriteString("    # Django needs a primary key; mark a unique column primary_key=True.\n")
+	case len(m.Keys) > 1:
+		var names []string
+		for _, key := range m.Keys {
+			names = append(names, fmt.Sprintf("\"%s\"", d.fieldName(m, key)))
+		}
+		sb.WriteString(fmt.Sprintf("    pk = models.CompositePrimaryKey(%s)\n", strings.Join(names, ", ")))
+	}
+
+	for i, col := range m.Columns {
+		if i > 0 && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		field, args, guessed := d.field(m, col)
+		isNull := strings.EqualFold(col.IsNullable, "YES")
+
+		if len(m.Keys) == 1 && common.IsPrimaryKey(col) {
+			args = append(args, "primary_key=True")
+		} else if strings.Contains(col.ColumnKey, "UNI") {
+			args = append(args, "unique=True")
+		}
+		if isNull {
+			args = append(args, "null=True", "blank=True")
+		}
+		// Computed columns are maintained by the database.
+		if col.IsGenerated == "YES" {
+			args = append(args, "editable=False")
+		}
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			args = append(args, fmt.Sprintf("help_text=%q", col.ColumnComment.String))
+		}
+
+		line := fmt.Sprintf("    %s = %s(%s)", d.fieldName(m, col), field, strings.Join(args, ", "))
+		if guessed {
+			line += "  # This field type is a guess."
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString("    class Meta:\n")
+	if opt.Unmanaged {
+		sb.WriteString("        managed = False\n")
+	}
+	sb.WriteString(fmt.Sprintf("        db_table = \"%s\"\n", m.Table))
+}
+
+func (d *Django) relation(m *ormModel, col domain.SqlData) *ormRelation {
+	i := slices.IndexFunc(m.Relations, func(r *ormRelation) bool { return r.Column == col.ColumnName })
+	if i < 0 {
+		return nil
+	}
+	return m.Relations[i]
+}
+
+// fieldName names a ForeignKey after the relation, as Django appends _id
+// to find its column.
+func (d *Django) fieldName(m *ormModel, col domain.SqlData) string {
+	if r := d.relation(m, col); r != nil {
+		return r.Field
+	}
+	return attributeName(col.ColumnName)
+}
+
+// field picks the model field for a column and its arguments, and reports
+// when the column type has no counterpart and the field is a guess.
+func (d *Django) field(m *ormModel, col domain.SqlData) (string, []string, bool) {
+	if r := d.relation(m, col); r != nil {
+		target := fmt.Sprintf("\"%s\"", r.Target.ClassName)
+		if r.Target == m {
+			target = "\"self\""
+		}
+		args := []string{target, "on_delete=models." + d.onDelete(r)}
+		if len(r.Target.Keys) != 1 || !strings.EqualFold(r.Target.Keys[0].ColumnName, r.RefCol) {
+			args = append(args, fmt.Sprintf("to_field=\"%s\"", attributeName(r.RefCol)))
+		}
+		if r.Field+"_id" != col.ColumnName {
+			args = append(args, fmt.Sprintf("db_column=\"%s\"", col.ColumnName))
+		}
+		args = append(args, fmt.Sprintf("related_name=\"%s\"", r.Inverse))
+		return "models.ForeignKey", args, false
+	}
+
+	var args []string
+	if attributeName(col.ColumnName) != col.ColumnName {
+		args = append(args, fmt.Sprintf("db_column=\"%s\"", col.ColumnName))
+	}
+
+	field, typeArgs, guessed := djangoField(m.dbType, col, len(m.Keys) == 1 && common.IsPrimaryKey(col))
+	if strings.HasPrefix(field, "ArrayField") {
+		d.imports.from("django.contrib.postgres.fields", "ArrayField")
+	}
+	return field, append(typeArgs, args...), guessed
+}
+
+// djangoOnDelete lists the on_delete handlers in django.db.models.
+var djangoOnDelete = []string{"CASCADE", "PROTECT", "RESTRICT", "SET_NULL", "SET_DEFAULT", "DO_NOTHING"}
+
+// onDelete picks the handler for a foreign key. The constraint's own delete
+// rule is not read: unmanaged tables leave deletes to the database, and
+// otherwise nullable keys are cleared rather than cascaded.
+func (d *Django) onDelete(r *ormRelation) string {
+	switch {
+	case d.django.OnDelete != "":
+		return d.django.OnDelete
+	case d.django.Unmanaged:
+		return "DO_NOTHING"
+	case r.Nullable:
+		return "SET_NULL"
+	default:
+		return "CASCADE"
+	}
+}
+
+func djangoField(dbType string, col domain.SqlData, primaryKey bool) (string, []string, bool) {
+	dataType := strings.ToLower(col.DataType)
+	length := int16(0)
+	if col.CharacterMaximumLength.Valid {
+		length = col.CharacterMaximumLength.Int16
+	}
+
+	if values := common.EnumValues(col); len(values) > 0 {
+		maxLength := 0
+		var choices []string
+		for _, value := range values {
+			maxLength = max(maxLength, len(value))
+			choices = append(choices, fmt.Sprintf("(\"%s\", \"%s\")", value, value))
+		}
+		return "models.CharField", []string{
+			fmt.Sprintf("max_length=%d", maxLength),
+			fmt.Sprintf("choices=[%s]", strings.Join(choices, ", ")),
+		}, false
+	}
+
+	identity := primaryKey && col.IsIdentity == "YES"
+
+	switch dataType {
+	case "tinyint", "smallint", "int2", "year":
+		if identity {
+			return "models.SmallAutoField", nil, false
+		}
+		return "models.SmallIntegerField", nil, false
+	case "int", "integer", "int4", "mediumint", "serial":
+		if identity || dataType == "serial" {
+			return "models.AutoField", nil, false
+		}
+		return "models.IntegerField", nil, false
+	case "bigint", "int8", "bigserial":
+		if identity || dataType == "bigserial" {
+			return "models.BigAutoField", nil, false
+		}
+		return "models.BigIntegerField", nil, false
+
+	case "decimal", "numeric":
+		if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+			return "models.DecimalField", []string{
+				fmt.Sprintf("max_digits=%d", col.NumericPrecision.Int16),
+				fmt.Sprintf("decimal_places=%d", col.NumericScale.Int16),
+			}, false
+		}
+		return "models.DecimalField", []string{"max_digits=65", "decimal_places=30"}, true
+	case "money":
+		return "models.DecimalField", []string{"max_digits=19", "decimal_places=4"}, false
+	case "smallmoney":
+		return "models.DecimalField", []string{"max_digits=10", "decimal_places=4"}, false
+	case "float", "double", "real", "float4", "float8", "double precision":
+		return "models.FloatField", nil, false
+
+	case "bool", "boolean":
+		return "models.BooleanField", nil, false
+	case "bit":
+		// A MySQL BIT(n) holds n bits rather than a flag; the width is its
+		// numeric precision.
+		if dbType == "mysql" && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 1 {
+			return "models.BinaryField", nil, true
+		}
+		return "models.BooleanField", nil, false
+
+	case "char", "varchar", "nchar", "nvarchar", "character", "character varying":
+		if length > 0 {
+			return "models.CharField", []string{fmt.Sprintf("max_length=%d", length)}, false
+		}
+		return "models.TextField", nil, false
+	case "text", "tinytext", "mediumtext", "longtext", "ntext", "citext", "xml", "set", "tsvector":
+		return "models.TextField", nil, false
+
+	case "date":
+		return "models.DateField", nil, false
+	case "datetime", "datetime2", "smalldatetime", "datetimeoffset",
+		"timestamp without time zone", "timestamptz", "timestamp with time zone":
+		return "models.DateTimeField", nil, false
+	case "timestamp":
+		// SQL Server's timestamp is a row version, not a point in time.
+		if dbType == "mssql" {
+			return "models.BinaryField", []string{"editable=False"}, false
+		}
+		return "models.DateTimeField", nil, false
+	case "time", "time without time zone", "timetz", "time with time zone":
+		return "models.TimeField", nil, false
+	case "interval":
+		return "models.DurationField", nil, false
+
+	case "uuid", "uniqueidentifier":
+		return "models.UUIDField", nil, false
+	case "json", "jsonb":
+		return "models.JSONField", nil, false
+	case "inet":
+		return "models.GenericIPAddressField", nil, false
+
+	case "binary", "varbinary":
+		if length > 0 {
+			return "models.BinaryField", []string{fmt.Sprintf("max_length=%d", length)}, false
+		}
+		return "models.BinaryField", nil, false
+	case "blob", "tinyblob", "mediumblob", "longblob", "bytea", "image":
+		return "models.BinaryField", nil, false
+	case "rowversion":
+		return "models.BinaryField", []string{"editable=False"}, false
+
+	case "_int4", "integer[]":
+		return "ArrayField", []string{"models.IntegerField()"}, false
+	case "_int8", "bigint[]":
+		return "ArrayField", []string{"models.BigIntegerField()"}, false
+	case "_text", "text[]":
+		return "ArrayField", []string{"models.TextField()"}, false
+	}
+
+	return "models.TextField", nil, true
+}
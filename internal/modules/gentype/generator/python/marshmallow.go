@@ -0,0 +1,136 @@
+package python
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Marshmallow writes marshmallow schemas that load and dump rows under their
+// column names.
+type Marshmallow struct{}
+
+func (d *Marshmallow) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+	if req.Suffix == "" {
+		className += "Schema"
+	}
+
+	var opt domain.PythonMarshmallowOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Python Marshmallow Options", fmt.Errorf("invalid python marshmallow options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var imports pyImports
+	imports.from("marshmallow", "Schema", "fields")
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("class %s(Schema):\n", className))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s\"\"\"\n\n", className))
+	}
+	if len(columns) == 0 {
+		sb.WriteString("    pass\n")
+	}
+
+	for _, col := range columns {
+		fieldName := attributeName(col.ColumnName)
+		field, args := marshmallowField(dbType, col)
+
+		isNull := strings.ToLower(col.IsNullable) == "yes"
+		switch {
+		// Values the database assigns are only ever dumped.
+		case col.IsIdentity == "YES" || col.IsGenerated == "YES":
+			args = append(args, "dump_only=True")
+		case isNull:
+			args = append(args, "allow_none=True")
+		default:
+			args = append(args, "required=True")
+		}
+
+		if values := common.EnumValues(col); len(values) > 0 {
+			imports.from("marshmallow", "validate")
+			args = append(args, fmt.Sprintf("validate=validate.OneOf([\"%s\"])", strings.Join(values, "\", \"")))
+		} else if n := maxLength(col); n > 0 && field == "fields.String" {
+			imports.from("marshmallow", "validate")
+			args = append(args, fmt.Sprintf("validate=validate.Length(max=%d)", n))
+		}
+
+		if fieldName != col.ColumnName {
+			args = append(args, fmt.Sprintf("data_key=\"%s\"", col.ColumnName))
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
+		}
+
+		sb.WriteString(fmt.Sprintf("    %s = %s(%s)\n", fieldName, field, strings.Join(args, ", ")))
+
+		if opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	imports.write(&out)
+	out.WriteString(sb.String())
+	return out.String(), nil
+}
+
+// marshmallowField picks the field class for a column from its Python type.
+func marshmallowField(dbType string, col domain.SqlData) (string, []string) {
+	var imports pyImports
+	pyType := pythonType(&imports, dbType, col)
+
+	switch {
+	case strings.HasPrefix(pyType, "Literal["):
+		return "fields.String", nil
+	case strings.HasPrefix(pyType, "dict["):
+		return "fields.Dict", nil
+	case pyType == "list[int]":
+		return "fields.List", []string{"fields.Integer()"}
+	case pyType == "list[str]":
+		return "fields.List", []string{"fields.String()"}
+	}
+
+	switch pyType {
+	case "int":
+		return "fields.Integer", nil
+	case "float":
+		return "fields.Float", nil
+	case "Decimal":
+		if col.NumericScale.Valid && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+			return "fields.Decimal", []string{fmt.Sprintf("places=%d", col.NumericScale.Int16)}
+		}
+		return "fields.Decimal", nil
+	case "str":
+		return "fields.String", nil
+	case "bool":
+		return "fields.Boolean", nil
+	case "date":
+		return "fields.Date", nil
+	case "datetime":
+		return "fields.DateTime", nil
+	case "time":
+		return "fields.Time", nil
+	case "timedelta":
+		return "fields.TimeDelta", nil
+	case "UUID":
+		return "fields.UUID", nil
+	default:
+		return "fields.Raw", nil
+	}
+}
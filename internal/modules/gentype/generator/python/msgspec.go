@@ -0,0 +1,103 @@
+package python
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Msgspec writes msgspec.Struct types. Fields keep the column name on the
+// wire when their Python name differs from it.
+type Msgspec struct{}
+
+func (d *Msgspec) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.PythonMsgspecOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Python Msgspec Options", fmt.Errorf("invalid python msgspec options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var imports pyImports
+	imports.module("msgspec")
+
+	var fields strings.Builder
+	var hasDefault []bool
+
+	for _, col := range columns {
+		fieldName := attributeName(col.ColumnName)
+		pyType := pythonType(&imports, dbType, col)
+
+		if n := maxLength(col); opt.Constraints && n > 0 && pyType == "str" {
+			imports.from("typing", "Annotated")
+			pyType = fmt.Sprintf("Annotated[str, msgspec.Meta(max_length=%d)]", n)
+		}
+
+		isOpt := opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes"
+		if isOpt {
+			imports.from("typing", "Optional")
+			pyType = fmt.Sprintf("Optional[%s]", pyType)
+		}
+		hasDefault = append(hasDefault, isOpt)
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			fields.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
+		}
+
+		line := fmt.Sprintf("    %s: %s", fieldName, pyType)
+		switch {
+		case fieldName != col.ColumnName && isOpt:
+			line += fmt.Sprintf(" = msgspec.field(default=None, name=\"%s\")", col.ColumnName)
+		case fieldName != col.ColumnName:
+			line += fmt.Sprintf(" = msgspec.field(name=\"%s\")", col.ColumnName)
+		case isOpt:
+			line += " = None"
+		}
+		fields.WriteString(line + "\n")
+
+		if opt.ExtraSpacing {
+			fields.WriteString("\n")
+		}
+	}
+
+	structArgs := []string{"msgspec.Struct"}
+	if needsKwOnly(hasDefault) {
+		structArgs = append(structArgs, "kw_only=True")
+	}
+	if opt.Rename != "" {
+		structArgs = append(structArgs, fmt.Sprintf("rename=\"%s\"", opt.Rename))
+	}
+	if opt.OmitDefaults {
+		structArgs = append(structArgs, "omit_defaults=True")
+	}
+	if opt.Frozen {
+		structArgs = append(structArgs, "frozen=True")
+	}
+
+	var sb strings.Builder
+	imports.write(&sb)
+
+	sb.WriteString(fmt.Sprintf("class %s(%s):\n", className, strings.Join(structArgs, ", ")))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s struct\"\"\"\n\n", className))
+	}
+	if len(columns) == 0 {
+		sb.WriteString("    pass\n")
+	}
+	sb.WriteString(fields.String())
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,478 @@
+package python
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// ormModels collects the tables of a request for the ORM styles. A
+// relationship is declared on both of its ends, so models are rendered by
+// Footer once every table is known.
+type ormModels struct {
+	opt     domain.PythonOrmOptions
+	models  []*ormModel
+	imports pyImports
+}
+
+type ormModel struct {
+	ClassName string
+	Table     string
+	Columns   []domain.SqlData
+	Keys      []domain.SqlData
+	Relations []*ormRelation
+	Inverse   []*ormRelation
+	dbType    string
+}
+
+type ormRelation struct {
+	Field     string
+	Inverse   string
+	Column    string
+	RefCol    string
+	Source    *ormModel
+	Target    *ormModel
+	Nullable  bool
+	Ambiguous bool
+}
+
+// ormType is the SQLAlchemy type of a column and the Python type it loads as.
+// Inferred types are the ones SQLModel derives from the Python type alone.
+type ormType struct {
+	Expr     string
+	Python   string
+	Inferred bool
+	imports  [][2]string
+}
+
+var pythonKeywords = []string{
+	"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue",
+	"def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+	"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+}
+
+var sqlalchemyDialects = map[string]string{
+	"mysql":    "mysql",
+	"postgres": "postgresql",
+	"mssql":    "mssql",
+}
+
+func (o *ormModels) collect(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	if err := json.Unmarshal(req.Options, &o.opt); err != nil {
+		return "Invalid Python ORM Options", fmt.Errorf("invalid python orm options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	o.models = append(o.models, &ormModel{
+		ClassName: req.Prefix + common.ToPascalCase(tbN) + req.Suffix,
+		Table:     tbN,
+		Columns:   columns,
+		Keys:      common.PrimaryKeys(columns),
+		dbType:    strings.ToLower(dbType),
+	})
+
+	return "", nil
+}
+
+func (o *ormModels) findModel(table string) *ormModel {
+	for _, m := range o.models {
+		if strings.EqualFold(m.Table, table) {
+			return m
+		}
+	}
+	return nil
+}
+
+// resolveRelations links foreign keys to bundled tables. References to
+// tables outside the request stay plain columns, as their ForeignKey could
+// not be resolved against the metadata.
+func (o *ormModels) resolveRelations() {
+	for _, m := range o.models {
+		var taken []string
+		for _, col := range m.Columns {
+			taken = append(taken, attributeName(col.ColumnName))
+		}
+
+		for _, col := range m.Columns {
+			if !col.ReferencedTable.Valid {
+				continue
+			}
+			target := o.findModel(col.ReferencedTable.String)
+			if target == nil {
+				continue
+			}
+
+			field := strings.TrimSuffix(attributeName(col.ColumnName), "_id")
+			if field == attributeName(col.ColumnName) || slices.Contains(taken, field) {
+				field = common.ToSnakeCase(target.ClassName)
+			}
+			taken = append(taken, field)
+
+			m.Relations = append(m.Relations, &ormRelation{
+				Field:    field,
+				Column:   col.ColumnName,
+				RefCol:   col.ReferencedColumn.String,
+				Source:   m,
+				Target:   target,
+				Nullable: strings.EqualFold(col.IsNullable, "YES"),
+			})
+		}
+	}
+
+	for _, m := range o.models {
+		for _, r := range m.Relations {
+			r.Inverse = common.ToSnakeCase(m.Table)
+			for _, other := range m.Relations {
+				if other != r && other.Target == r.Target {
+					// Several keys to the same table need telling apart.
+					r.Inverse += "_" + r.Field
+					r.Ambiguous = true
+					break
+				}
+			}
+			if r.Target == m && r.Inverse == r.Field {
+				r.Inverse += "_children"
+			}
+			r.Target.Inverse = append(r.Target.Inverse, r)
+		}
+	}
+}
+
+// foreignKey names the referenced column the way ForeignKey expects it.
+func (o *ormModels) foreignKey(col domain.SqlData) string {
+	if !col.ReferencedTable.Valid || o.findModel(col.ReferencedTable.String) == nil {
+		return ""
+	}
+	reference := col.ReferencedTable.String + "." + col.ReferencedColumn.String
+	if o.opt.Schema != "" {
+		reference = o.opt.Schema + "." + reference
+	}
+	return reference
+}
+
+// remoteSide points a self-referencing relationship at the parent's key.
+func remoteSide(r *ormRelation) string {
+	if r.Target != r.Source {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s", r.Target.ClassName, attributeName(r.RefCol))
+}
+
+func attributeName(columnName string) string {
+	name := common.ToSnakeCase(columnName)
+	if slices.Contains(pythonKeywords, name) {
+		name += "_"
+	}
+	return name
+}
+
+// columnType maps a column to a SQLAlchemy type, using the dialect's own
+// types where a generic one would lose the column's exact definition.
+func (o *ormModels) columnType(dbType string, col domain.SqlData) ormType {
+	dataType := strings.ToLower(col.DataType)
+	length := ""
+	if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		length = fmt.Sprint(col.CharacterMaximumLength.Int16)
+	}
+
+	generic := func(name, args, python string) ormType {
+		return ormType{Expr: typeExpr(name, args), Python: python, imports: [][2]string{{"sqlalchemy", name}}}
+	}
+	dialect := func(name, args, python string) ormType {
+		return ormType{Expr: typeExpr(name, args), Python: python,
+			imports: [][2]string{{"sqlalchemy.dialects." + sqlalchemyDialects[dbType], name}}}
+	}
+	array := func(item, python string) ormType {
+		t := dialect("ARRAY", item, python)
+		t.imports = append(t.imports, [2]string{"sqlalchemy", item})
+		return t
+	}
+	inferred := func(t ormType) ormType {
+		t.Inferred = true
+		return t
+	}
+	python := func(module, name string) string {
+		o.imports.module(module)
+		return module + "." + name
+	}
+	numeric := func() ormType {
+		args := ""
+		if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+			args = fmt.Sprintf("%d, %d", col.NumericPrecision.Int16, col.NumericScale.Int16)
+		}
+		return inferred(generic("Numeric", args, python("decimal", "Decimal")))
+	}
+	jsonType := func() string {
+		o.imports.from("typing", "Any")
+		return "dict[str, Any]"
+	}
+
+	if values := common.EnumValues(col); len(values) > 0 {
+		args := fmt.Sprintf("\"%s\"", strings.Join(values, "\", \""))
+		// Postgres enums are named types of their own, reported as the udt_name.
+		if dbType == "postgres" {
+			args += fmt.Sprintf(", name=\"%s\"", col.DataType)
+		}
+		return generic("Enum", args, "str")
+	}
+
+	switch dbType {
+	case "mysql":
+		switch dataType {
+		case "tinyint":
+			return dialect("TINYINT", "", "int")
+		case "smallint":
+			return generic("SmallInteger", "", "int")
+		case "mediumint":
+			return dialect("MEDIUMINT", "", "int")
+		case "int", "integer":
+			return inferred(generic("Integer", "", "int"))
+		case "bigint":
+			return generic("BigInteger", "", "int")
+		case "decimal", "numeric":
+			return numeric()
+		case "float":
+			return inferred(generic("Float", "", "float"))
+		case "double":
+			return generic("Double", "", "float")
+		case "bit":
+			// MySQL reports the BIT width as numeric precision.
+			width := ""
+			if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+				width = fmt.Sprint(col.NumericPrecision.Int16)
+			}
+			return dialect("BIT", width, "int")
+		case "boolean", "bool":
+			return inferred(generic("Boolean", "", "bool"))
+		case "char":
+			return generic("CHAR", length, "str")
+		case "varchar":
+			return inferred(generic("String", length, "str"))
+		case "text":
+			return generic("Text", "", "str")
+		case "tinytext", "mediumtext", "longtext":
+			return dialect(strings.ToUpper(dataType), "", "str")
+		case "json":
+			return generic("JSON", "", jsonType())
+		case "set":
+			return dialect("SET", "", "str")
+		case "date":
+			return inferred(generic("Date", "", python("datetime", "date")))
+		case "datetime":
+			return inferred(generic("DateTime", "", python("datetime", "datetime")))
+		case "timestamp":
+			return dialect("TIMESTAMP", "", python("datetime", "datetime"))
+		case "time":
+			return inferred(generic("Time", "", python("datetime", "time")))
+		case "year":
+			return dialect("YEAR", "", "int")
+		case "binary":
+			return generic("BINARY", length, "bytes")
+		case "varbinary":
+			return generic("VARBINARY", length, "bytes")
+		case "blob":
+			return inferred(generic("LargeBinary", "", "bytes"))
+		case "tinyblob", "mediumblob", "longblob":
+			return dialect(strings.ToUpper(dataType), "", "bytes")
+		}
+	case "postgres":
+		switch dataType {
+		case "smallint", "int2":
+			return generic("SmallInteger", "", "int")
+		case "integer", "int", "int4", "serial":
+			return inferred(generic("Integer", "", "int"))
+		case "bigint", "int8", "bigserial":
+			return generic("BigInteger", "", "int")
+		case "numeric", "decimal":
+			return numeric()
+		case "money":
+			return dialect("MONEY", "", python("decimal", "Decimal"))
+		case "real", "float4":
+			return generic("REAL", "", "float")
+		case "double precision", "float8":
+			return generic("Double", "", "float")
+		case "varchar", "character varying":
+			return inferred(generic("String", length, "str"))
+		case "char", "character":
+			return generic("CHAR", length, "str")
+		case "text":
+			return generic("Text", "", "str")
+		case "citext":
+			return dialect("CITEXT", "", "str")
+		case "boolean", "bool":
+			return inferred(generic("Boolean", "", "bool"))
+		case "date":
+			return inferred(generic("Date", "", python("datetime", "date")))
+		case "time", "time without time zone":
+			return inferred(generic("Time", "", python("datetime", "time")))
+		case "timetz", "time with time zone":
+			return generic("Time", "timezone=True", python("datetime", "time"))
+		case "timestamp", "timestamp without time zone":
+			return inferred(generic("DateTime", "", python("datetime", "datetime")))
+		case "timestamptz", "timestamp with time zone":
+			return generic("DateTime", "timezone=True", python("datetime", "datetime"))
+		case "interval":
+			return dialect("INTERVAL", "", python("datetime", "timedelta"))
+		case "bytea":
+			return inferred(generic("LargeBinary", "", "bytes"))
+		case "uuid":
+			return inferred(generic("Uuid", "", python("uuid", "UUID")))
+		case "json":
+			return generic("JSON", "", jsonType())
+		case "jsonb":
+			return dialect("JSONB", "", jsonType())
+		case "inet", "cidr", "macaddr", "tsvector":
+			return dialect(strings.ToUpper(dataType), "", "str")
+		case "_int4", "integer[]":
+			return array("Integer", "list[int]")
+		case "_int8", "bigint[]":
+			return array("BigInteger", "list[int]")
+		case "_text", "text[]":
+			return array("Text", "list[str]")
+		}
+	case "mssql":
+		// varchar(max) and friends report a length of -1 and are written without one.
+		switch dataType {
+		case "tinyint":
+			return dialect("TINYINT", "", "int")
+		case "smallint":
+			return generic("SmallInteger", "", "int")
+		case "int":
+			return inferred(generic("Integer", "", "int"))
+		case "bigint":
+			return generic("BigInteger", "", "int")
+		case "decimal", "numeric":
+			return numeric()
+		case "money", "smallmoney":
+			return dialect(strings.ToUpper(dataType), "", python("decimal", "Decimal"))
+		case "float":
+			return inferred(generic("Float", "", "float"))
+		case "real":
+			return generic("REAL", "", "float")
+		case "bit":
+			return inferred(generic("Boolean", "", "bool"))
+		case "char":
+			return generic("CHAR", length, "str")
+		case "varchar":
+			return inferred(generic("String", length, "str"))
+		case "nchar":
+			return generic("NCHAR", length, "str")
+		case "nvarchar":
+			return generic("NVARCHAR", length, "str")
+		case "text":
+			return dialect("TEXT", "", "str")
+		case "ntext":
+			return dialect("NTEXT", "", "str")
+		case "xml":
+			return dialect("XML", "", "str")
+		case "date":
+			return inferred(generic("Date", "", python("datetime", "date")))
+		case "time":
+			return inferred(generic("Time", "", python("datetime", "time")))
+		case "datetime":
+			return inferred(generic("DateTime", "", python("datetime", "datetime")))
+		case "datetime2", "smalldatetime", "datetimeoffset":
+			return dialect(strings.ToUpper(dataType), "", python("datetime", "datetime"))
+		case "binary":
+			return generic("BINARY", length, "bytes")
+		case "varbinary":
+			return generic("VARBINARY", length, "bytes")
+		case "image":
+			return dialect("IMAGE", "", "bytes")
+		case "rowversion", "timestamp":
+			return dialect("ROWVERSION", "", "bytes")
+		case "uniqueidentifier":
+			return inferred(generic("Uuid", "", python("uuid", "UUID")))
+		}
+	}
+
+	o.imports.from("typing", "Any")
+	return ormType{Expr: "NullType", Python: "Any", imports: [][2]string{{"sqlalchemy.types", "NullType"}}}
+}
+
+// useType imports what the type's SQLAlchemy expression refers to.
+func (o *ormModels) useType(t ormType) string {
+	for _, i := range t.imports {
+		o.imports.from(i[0], i[1])
+	}
+	return t.Expr
+}
+
+func typeExpr(name, args string) string {
+	if args == "" {
+		return name
+	}
+	return fmt.Sprintf("%s(%s)", name, args)
+}
+
+var stdlibModules = []string{"datetime", "decimal", "typing", "uuid"}
+
+// pyImports collects the imports of a module, written stdlib first.
+type pyImports struct {
+	modules []string
+	names   map[string][]string
+}
+
+func (i *pyImports) module(name string) {
+	if !slices.Contains(i.modules, name) {
+		i.modules = append(i.modules, name)
+	}
+}
+
+func (i *pyImports) from(module string, names ...string) {
+	if i.names == nil {
+		i.names = map[string][]string{}
+	}
+	for _, name := range names {
+		if !slices.Contains(i.names[module], name) {
+			i.names[module] = append(i.names[module], name)
+		}
+	}
+}
+
+func (i *pyImports) write(sb *strings.Builder) {
+	var stdlib, thirdParty []string
+
+	slices.Sort(i.modules)
+	for _, name := range i.modules {
+		if slices.Contains(stdlibModules, name) {
+			stdlib = append(stdlib, "import "+name)
+		} else {
+			thirdParty = append(thirdParty, "import "+name)
+		}
+	}
+
+	var modules []string
+	for module := range i.names {
+		modules = append(modules, module)
+	}
+	slices.Sort(modules)
+	for _, module := range modules {
+		names := slices.Clone(i.names[module])
+		slices.Sort(names)
+		line := fmt.Sprintf("from %s import %s", module, strings.Join(names, ", "))
+		if slices.Contains(stdlibModules, module) {
+			stdlib = append(stdlib, line)
+		} else {
+			thirdParty = append(thirdParty, line)
+		}
+	}
+
+	for _, group := range [][]string{stdlib, thirdParty} {
+		if len(group) == 0 {
+			continue
+		}
+		sb.WriteString(strings.Join(group, "\n"))
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString("\n")
+}
@@ -23,6 +23,14 @@ func (d *PydanticDto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN strin
 		return "Invalid Python Pydantic Options", fmt.Errorf("invalid python pydantic options: %w", err)
 	}
 
+	if isPydanticV2(opt.Version) {
+		columns, err := common.ScanColumns(rows)
+		if err != nil {
+			return "", err
+		}
+		return writePydanticV2(columns, opt, className, dbType), nil
+	}
+
 	sb.WriteString("from pydantic import BaseModel")
 
 	if opt.Validation {
@@ -47,41 +55,24 @@ func (d *PydanticDto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN strin
 
 	hasField := false
 
-	for rows.Next() {
-
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	for _, col := range columns {
 		hasField = true
 
-		fieldName := common.ToSnakeCase(columnName)
-		pyType := mapPydanticType(dbType, dataType, opt.StrictTypes)
+		fieldName := common.ToSnakeCase(col.ColumnName)
+		pyType := mapPydanticType(dbType, col.DataType, opt.StrictTypes)
 
-		isOpt := opt.OptionalFields || strings.ToLower(isNullable) == "yes"
+		isOpt := opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes"
 		if isOpt {
 			pyType = fmt.Sprintf("Optional[%s]", pyType)
 		}
 
-		if opt.Comments && columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
-			sb.WriteString(fmt.Sprintf("    # %s\n", columnComment.String))
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
 		}
 
 		fieldLine := fmt.Sprintf("    %s: %s", fieldName, pyType)
@@ -89,11 +80,11 @@ func (d *PydanticDto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN strin
 		var fieldArgs []string
 
 		if opt.Validation {
-			if columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
-				fieldArgs = append(fieldArgs, fmt.Sprintf("description=\"%s\"", columnComment.String))
+			if col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+				fieldArgs = append(fieldArgs, fmt.Sprintf("description=\"%s\"", col.ColumnComment.String))
 			}
 			if opt.AliasGenerator {
-				fieldArgs = append(fieldArgs, fmt.Sprintf("alias=\"%s\"", columnName))
+				fieldArgs = append(fieldArgs, fmt.Sprintf("alias=\"%s\"", col.ColumnName))
 			}
 		}
 
@@ -0,0 +1,169 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// integerRanges bound the integer columns whose range is narrower than
+// Python's int. MySQL's DATA_TYPE does not say whether a column is unsigned,
+// so they only apply to Postgres and SQL Server.
+var integerRanges = map[string][2]string{
+	"smallint": {"-32768", "32767"},
+	"int2":     {"-32768", "32767"},
+	"int":      {"-2147483648", "2147483647"},
+	"integer":  {"-2147483648", "2147483647"},
+	"int4":     {"-2147483648", "2147483647"},
+}
+
+func isPydanticV2(version string) bool {
+	switch strings.ToLower(strings.TrimSpace(version)) {
+	case "2", "v2":
+		return true
+	default:
+		return false
+	}
+}
+
+// writePydanticV2 writes the model with pydantic 2 idioms: a ConfigDict,
+// constraints as Annotated metadata and validators where no constraint
+// covers the column.
+func writePydanticV2(columns []domain.SqlData, opt domain.PythonPydanticOptions, className, dbType string) string {
+	var imports pyImports
+	imports.from("pydantic", "BaseModel")
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("class %s(BaseModel):\n", className))
+
+	if opt.Docstrings {
+		body.WriteString(fmt.Sprintf("    \"\"\"%s model\"\"\"\n\n", className))
+	}
+
+	var config []string
+	if opt.OrmMode {
+		config = append(config, "from_attributes=True")
+	}
+	if opt.AllowPopulationByFieldName || opt.AliasGenerator {
+		config = append(config, "populate_by_name=True")
+	}
+	if opt.StrictTypes {
+		config = append(config, "strict=True")
+	}
+	if opt.UseEnumValues {
+		config = append(config, "use_enum_values=True")
+	}
+	if opt.ArbitraryTypesAllowed {
+		config = append(config, "arbitrary_types_allowed=True")
+	}
+	if len(config) > 0 {
+		imports.from("pydantic", "ConfigDict")
+		body.WriteString(fmt.Sprintf("    model_config = ConfigDict(%s)\n\n", strings.Join(config, ", ")))
+	}
+
+	var padded []string
+
+	for _, col := range columns {
+		fieldName := attributeName(col.ColumnName)
+		pyType := pydanticV2Type(&imports, dbType, col)
+		dataType := strings.ToLower(col.DataType)
+
+		var constraints []string
+		if pyType == "str" && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+			constraints = append(constraints, fmt.Sprintf("StringConstraints(max_length=%d)", col.CharacterMaximumLength.Int16))
+			imports.from("pydantic", "StringConstraints")
+		}
+		if opt.Validation {
+			var limits []string
+			if r, ok := integerRanges[dataType]; ok && pyType == "int" && dbType != "mysql" {
+				limits = append(limits, "ge="+r[0], "le="+r[1])
+			}
+			if pyType == "Decimal" && col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+				limits = append(limits, fmt.Sprintf("max_digits=%d", col.NumericPrecision.Int16),
+					fmt.Sprintf("decimal_places=%d", col.NumericScale.Int16))
+			}
+			if len(limits) > 0 {
+				imports.from("pydantic", "Field")
+				constraints = append(constraints, fmt.Sprintf("Field(%s)", strings.Join(limits, ", ")))
+			}
+		}
+		if len(constraints) > 0 {
+			imports.from("typing", "Annotated")
+			pyType = fmt.Sprintf("Annotated[%s, %s]", pyType, strings.Join(constraints, ", "))
+		}
+
+		// Fixed length CHAR columns come back padded with spaces.
+		if opt.Validation && (dataType == "char" || dataType == "nchar" || dataType == "character") {
+			padded = append(padded, fieldName)
+		}
+
+		isOpt := opt.OptionalFields || strings.ToLower(col.IsNullable) == "yes"
+		if isOpt {
+			imports.from("typing", "Optional")
+			pyType = fmt.Sprintf("Optional[%s]", pyType)
+		}
+
+		var fieldArgs []string
+		if isOpt || opt.DefaultValues {
+			fieldArgs = append(fieldArgs, "default=None")
+		}
+		if opt.AliasGenerator || fieldName != col.ColumnName {
+			fieldArgs = append(fieldArgs, fmt.Sprintf("alias=\"%s\"", col.ColumnName))
+		}
+		if opt.Validation && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			fieldArgs = append(fieldArgs, fmt.Sprintf("description=%q", col.ColumnComment.String))
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			body.WriteString(fmt.Sprintf("    # %s\n", col.ColumnComment.String))
+		}
+
+		line := fmt.Sprintf("    %s: %s", fieldName, pyType)
+		switch {
+		case len(fieldArgs) == 1 && fieldArgs[0] == "default=None":
+			line += " = None"
+		case len(fieldArgs) > 0:
+			imports.from("pydantic", "Field")
+			line += fmt.Sprintf(" = Field(%s)", strings.Join(fieldArgs, ", "))
+		}
+		body.WriteString(line + "\n")
+
+		if opt.ExtraSpacing {
+			body.WriteString("\n")
+		}
+	}
+
+	if len(columns) == 0 {
+		body.WriteString("    pass\n")
+	}
+
+	if len(padded) > 0 {
+		imports.from("pydantic", "field_validator")
+		var names []string
+		for _, name := range padded {
+			names = append(names, fmt.Sprintf("\"%s\"", name))
+		}
+		body.WriteString("\n")
+		body.WriteString(fmt.Sprintf("    @field_validator(%s, mode=\"before\")\n", strings.Join(names, ", ")))
+		body.WriteString("    @classmethod\n")
+		body.WriteString("    def strip_char_padding(cls, value):\n")
+		body.WriteString("        return value.rstrip() if isinstance(value, str) else value\n")
+	}
+
+	var sb strings.Builder
+	imports.write(&sb)
+	sb.WriteString(body.String())
+	return sb.String()
+}
+
+// pydanticV2Type prefers pydantic's AwareDatetime for timezone aware
+// columns.
+func pydanticV2Type(imports *pyImports, dbType string, col domain.SqlData) string {
+	switch strings.ToLower(col.DataType) {
+	case "timestamptz", "timestamp with time zone", "datetimeoffset":
+		imports.from("pydantic", "AwareDatetime")
+		return "AwareDatetime"
+	}
+	return pythonType(imports, dbType, col)
+}
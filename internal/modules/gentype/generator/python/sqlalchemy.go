@@ -0,0 +1,140 @@
+package python
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// SqlAlchemy writes SQLAlchemy 2.0 declarative models.
+type SqlAlchemy struct {
+	ormModels
+}
+
+func (s *SqlAlchemy) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	return s.collect(rows, req, tbN, dbType)
+}
+
+func (s *SqlAlchemy) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	s.resolveRelations()
+	s.imports.from("sqlalchemy.orm", "DeclarativeBase", "Mapped", "mapped_column")
+
+	var body strings.Builder
+	body.WriteString("class Base(DeclarativeBase):\n")
+	body.WriteString("    pass\n")
+
+	for _, m := range s.models {
+		body.WriteString("\n\n")
+		s.writeModel(&body, m)
+	}
+
+	var sb strings.Builder
+	s.imports.write(&sb)
+	sb.WriteString(body.String())
+
+	return sb.String(), nil
+}
+
+func (s *SqlAlchemy) writeModel(sb *strings.Builder, m *ormModel) {
+	opt := s.opt
+
+	sb.WriteString(fmt.Sprintf("class %s(Base):\n", m.ClassName))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s model\"\"\"\n\n", m.ClassName))
+	}
+	sb.WriteString(fmt.Sprintf("    __tablename__ = \"%s\"\n", m.Table))
+	if opt.Schema != "" {
+		sb.WriteString(fmt.Sprintf("    __table_args__ = {\"schema\": \"%s\"}\n", opt.Schema))
+	}
+	sb.WriteString("\n")
+
+	for i, col := range m.Columns {
+		if i > 0 && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		name := attributeName(col.ColumnName)
+		t := s.columnType(m.dbType, col)
+		isNull := strings.EqualFold(col.IsNullable, "YES")
+
+		pyType := t.Python
+		if isNull {
+			s.imports.from("typing", "Optional")
+			pyType = fmt.Sprintf("Optional[%s]", pyType)
+		}
+
+		var args []string
+		if name != col.ColumnName {
+			args = append(args, fmt.Sprintf("\"%s\"", col.ColumnName))
+		}
+		args = append(args, s.useType(t))
+		if reference := s.foreignKey(col); reference != "" {
+			s.imports.from("sqlalchemy", "ForeignKey")
+			args = append(args, fmt.Sprintf("ForeignKey(\"%s\")", reference))
+		}
+
+		isKey := false
+		for _, key := range m.Keys {
+			isKey = isKey || key.ColumnName == col.ColumnName
+		}
+		if isKey {
+			args = append(args, "primary_key=True")
+			if col.IsIdentity == "YES" && len(m.Keys) == 1 {
+				args = append(args, "autoincrement=True")
+			}
+		} else {
+			args = append(args, fmt.Sprintf("nullable=%s", pythonBool(isNull)))
+		}
+		if strings.Contains(col.ColumnKey, "UNI") {
+			args = append(args, "unique=True")
+		}
+		// Computed columns are read back after every write.
+		if col.IsGenerated == "YES" {
+			s.imports.from("sqlalchemy", "FetchedValue")
+			args = append(args, "server_default=FetchedValue()", "server_onupdate=FetchedValue()")
+		}
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			args = append(args, fmt.Sprintf("comment=%q", col.ColumnComment.String))
+		}
+
+		sb.WriteString(fmt.Sprintf("    %s: Mapped[%s] = mapped_column(%s)\n", name, pyType, strings.Join(args, ", ")))
+	}
+
+	if len(m.Relations) > 0 || len(m.Inverse) > 0 {
+		s.imports.from("sqlalchemy.orm", "relationship")
+		sb.WriteString("\n")
+	}
+
+	for _, r := range m.Relations {
+		target := fmt.Sprintf("\"%s\"", r.Target.ClassName)
+		if r.Nullable {
+			s.imports.from("typing", "Optional")
+			target = fmt.Sprintf("Optional[%s]", target)
+		}
+		args := []string{fmt.Sprintf("back_populates=\"%s\"", r.Inverse)}
+		if r.Ambiguous {
+			args = append(args, fmt.Sprintf("foreign_keys=[%s]", attributeName(r.Column)))
+		}
+		if side := remoteSide(r); side != "" {
+			args = append(args, fmt.Sprintf("remote_side=\"%s\"", side))
+		}
+		sb.WriteString(fmt.Sprintf("    %s: Mapped[%s] = relationship(%s)\n", r.Field, target, strings.Join(args, ", ")))
+	}
+
+	for _, r := range m.Inverse {
+		args := []string{fmt.Sprintf("back_populates=\"%s\"", r.Field)}
+		if r.Ambiguous {
+			args = append(args, fmt.Sprintf("foreign_keys=\"[%s.%s]\"", r.Source.ClassName, attributeName(r.Column)))
+		}
+		sb.WriteString(fmt.Sprintf("    %s: Mapped[list[\"%s\"]] = relationship(%s)\n", r.Inverse, r.Source.ClassName, strings.Join(args, ", ")))
+	}
+}
+
+func pythonBool(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
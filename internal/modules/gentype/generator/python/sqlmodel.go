@@ -0,0 +1,156 @@
+package python
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// SqlModel writes SQLModel table models, which are pydantic models and
+// SQLAlchemy mappings at once.
+type SqlModel struct {
+	ormModels
+}
+
+func (s *SqlModel) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	return s.collect(rows, req, tbN, dbType)
+}
+
+func (s *SqlModel) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	s.resolveRelations()
+	s.imports.from("sqlmodel", "Field", "SQLModel")
+
+	var body strings.Builder
+	for i, m := range s.models {
+		if i > 0 {
+			body.WriteString("\n\n")
+		}
+		s.writeModel(&body, m)
+	}
+
+	var sb strings.Builder
+	s.imports.write(&sb)
+	sb.WriteString(body.String())
+
+	return sb.String(), nil
+}
+
+func (s *SqlModel) writeModel(sb *strings.Builder, m *ormModel) {
+	opt := s.opt
+
+	sb.WriteString(fmt.Sprintf("class %s(SQLModel, table=True):\n", m.ClassName))
+	if opt.Docstrings {
+		sb.WriteString(fmt.Sprintf("    \"\"\"%s model\"\"\"\n\n", m.ClassName))
+	}
+	sb.WriteString(fmt.Sprintf("    __tablename__ = \"%s\"\n", m.Table))
+	if opt.Schema != "" {
+		sb.WriteString(fmt.Sprintf("    __table_args__ = {\"schema\": \"%s\"}\n", opt.Schema))
+	}
+	sb.WriteString("\n")
+
+	for i, col := range m.Columns {
+		if i > 0 && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		name := attributeName(col.ColumnName)
+		t := s.columnType(m.dbType, col)
+
+		isKey := false
+		for _, key := range m.Keys {
+			isKey = isKey || key.ColumnName == col.ColumnName
+		}
+		generated := col.IsIdentity == "YES" || col.IsGenerated == "YES"
+		// Keys and computed values the database assigns are None until flushed.
+		optional := strings.EqualFold(col.IsNullable, "YES") || generated
+
+		pyType := t.Python
+		var args []string
+		if optional {
+			s.imports.from("typing", "Optional")
+			pyType = fmt.Sprintf("Optional[%s]", pyType)
+			args = append(args, "default=None")
+		}
+		if isKey {
+			args = append(args, "primary_key=True")
+		} else if optional && !strings.EqualFold(col.IsNullable, "YES") {
+			args = append(args, "nullable=False")
+		}
+		if reference := s.foreignKey(col); reference != "" {
+			args = append(args, fmt.Sprintf("foreign_key=\"%s\"", reference))
+		}
+		if strings.Contains(col.ColumnKey, "UNI") {
+			args = append(args, "unique=True")
+		}
+
+		// SQLModel derives the column type from the annotation; anything it
+		// would derive differently is passed to SQLAlchemy as is.
+		if t.Inferred {
+			switch t.Python {
+			case "str":
+				if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+					args = append(args, fmt.Sprintf("max_length=%d", col.CharacterMaximumLength.Int16))
+				}
+			case "decimal.Decimal":
+				if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+					args = append(args, fmt.Sprintf("max_digits=%d", col.NumericPrecision.Int16),
+						fmt.Sprintf("decimal_places=%d", col.NumericScale.Int16))
+				}
+			}
+		} else {
+			args = append(args, "sa_type="+s.useType(t))
+		}
+
+		var columnArgs []string
+		if name != col.ColumnName {
+			columnArgs = append(columnArgs, fmt.Sprintf("\"name\": \"%s\"", col.ColumnName))
+		}
+		if col.IsGenerated == "YES" {
+			s.imports.from("sqlalchemy", "FetchedValue")
+			columnArgs = append(columnArgs, "\"server_default\": FetchedValue()", "\"server_onupdate\": FetchedValue()")
+		}
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			columnArgs = append(columnArgs, fmt.Sprintf("\"comment\": %q", col.ColumnComment.String))
+		}
+		if len(columnArgs) > 0 {
+			args = append(args, fmt.Sprintf("sa_column_kwargs={%s}", strings.Join(columnArgs, ", ")))
+		}
+
+		line := fmt.Sprintf("    %s: %s", name, pyType)
+		if len(args) > 0 {
+			line += fmt.Sprintf(" = Field(%s)", strings.Join(args, ", "))
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	if len(m.Relations) > 0 || len(m.Inverse) > 0 {
+		s.imports.from("sqlmodel", "Relationship")
+		sb.WriteString("\n")
+	}
+
+	for _, r := range m.Relations {
+		s.imports.from("typing", "Optional")
+		args := []string{fmt.Sprintf("back_populates=\"%s\"", r.Inverse)}
+		var kwargs []string
+		if r.Ambiguous {
+			kwargs = append(kwargs, fmt.Sprintf("\"foreign_keys\": \"[%s.%s]\"", m.ClassName, attributeName(r.Column)))
+		}
+		if side := remoteSide(r); side != "" {
+			kwargs = append(kwargs, fmt.Sprintf("\"remote_side\": \"%s\"", side))
+		}
+		if len(kwargs) > 0 {
+			args = append(args, fmt.Sprintf("sa_relationship_kwargs={%s}", strings.Join(kwargs, ", ")))
+		}
+		sb.WriteString(fmt.Sprintf("    %s: Optional[\"%s\"] = Relationship(%s)\n", r.Field, r.Target.ClassName, strings.Join(args, ", ")))
+	}
+
+	for _, r := range m.Inverse {
+		args := []string{fmt.Sprintf("back_populates=\"%s\"", r.Field)}
+		if r.Ambiguous {
+			args = append(args, fmt.Sprintf("sa_relationship_kwargs={\"foreign_keys\": \"[%s.%s]\"}", r.Source.ClassName, attributeName(r.Column)))
+		}
+		sb.WriteString(fmt.Sprintf("    %s: list[\"%s\"] = Relationship(%s)\n", r.Inverse, r.Source.ClassName, strings.Join(args, ", ")))
+	}
+}
@@ -0,0 +1,63 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// pythonType refines the plain class mapping with the standard library
+// types that hold a column's values exactly, importing what it uses.
+func pythonType(imports *pyImports, dbType string, col domain.SqlData) string {
+	if values := common.EnumValues(col); len(values) > 0 {
+		imports.from("typing", "Literal")
+		return fmt.Sprintf("Literal[\"%s\"]", strings.Join(values, "\", \""))
+	}
+
+	switch strings.ToLower(col.DataType) {
+	case "decimal", "numeric", "money", "smallmoney":
+		imports.from("decimal", "Decimal")
+		return "Decimal"
+	case "uuid", "uniqueidentifier":
+		imports.from("uuid", "UUID")
+		return "UUID"
+	case "json", "jsonb":
+		imports.from("typing", "Any")
+		return "dict[str, Any]"
+	case "interval":
+		imports.from("datetime", "timedelta")
+		return "timedelta"
+	}
+
+	pyType := mapDBToPythonType(dbType, col.DataType)
+	switch pyType {
+	case "date", "datetime", "time":
+		imports.from("datetime", pyType)
+	case "Any":
+		imports.from("typing", "Any")
+	}
+	return pyType
+}
+
+// needsKwOnly reports whether a field with a default precedes one without,
+// which positional constructors reject.
+func needsKwOnly(hasDefault []bool) bool {
+	defaulted := false
+	for _, d := range hasDefault {
+		if defaulted && !d {
+			return true
+		}
+		defaulted = defaulted || d
+	}
+	return false
+}
+
+// maxLength returns the declared length of a character column, or 0.
+func maxLength(col domain.SqlData) int16 {
+	if col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+		return col.CharacterMaximumLength.Int16
+	}
+	return 0
+}
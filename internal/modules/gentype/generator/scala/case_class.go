@@ -0,0 +1,370 @@
+package scala
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+type CaseClass struct{}
+
+func (c *CaseClass) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.ScalaOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Scala Options", fmt.Errorf("invalid Scala options: %w", err)
+	}
+
+	type field struct {
+		Name       string
+		Column     string
+		Type       string
+		BaseType   string
+		Comment    string
+		MaxLength  sql.NullInt16
+		PrimaryKey bool
+	}
+
+	var fields []field
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	for _, col := range columns {
+		var scalaType string
+		switch strings.ToLower(dbType) {
+		case "mysql":
+			scalaType = mapMySQLToScalaType(col.DataType)
+		case "postgres":
+			scalaType = mapPostgresToScalaType(col.DataType)
+		case "mssql":
+			scalaType = mapMSSQLToScalaType(col.DataType)
+		default:
+			scalaType = "Any"
+		}
+
+		fieldType := scalaType
+		if strings.EqualFold(col.IsNullable, "YES") {
+			fieldType = fmt.Sprintf("Option[%s]", scalaType)
+		}
+
+		comment := ""
+		if col.ColumnComment.Valid {
+			comment = strings.TrimSpace(col.ColumnComment.String)
+		}
+
+		fields = append(fields, field{
+			Name:       escapeIdentifier(common.ToCamelCase(col.ColumnName)),
+			Column:     col.ColumnName,
+			Type:       fieldType,
+			BaseType:   scalaType,
+			Comment:    comment,
+			MaxLength:  col.CharacterMaximumLength,
+			PrimaryKey: strings.Contains(col.ColumnKey, "PRI"),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	wildcard := "_"
+	if opt.Scala3 {
+		wildcard = "*"
+	}
+
+	if opt.CirceCodec {
+		sb.WriteString("import io.circe.Codec\n")
+		if opt.SnakeCaseKeys {
+			if opt.Scala3 {
+				sb.WriteString("import io.circe.derivation.{Configuration, ConfiguredCodec}\n")
+			} else {
+				sb.WriteString("import io.circe.generic.extras.Configuration\n")
+				sb.WriteString("import io.circe.generic.extras.semiauto.deriveConfiguredCodec\n")
+			}
+		} else if !opt.Scala3 {
+			sb.WriteString("import io.circe.generic.semiauto.deriveCodec\n")
+		}
+	}
+	if opt.SlickTable {
+		sb.WriteString(fmt.Sprintf("import slick.jdbc.%s.api.%s\n", slickProfile(dbType), wildcard))
+	}
+	if opt.CirceCodec || opt.SlickTable {
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("final case class %s(\n", className))
+	for i, f := range fields {
+		if opt.Comments && f.Comment != "" {
+			sb.WriteString(fmt.Sprintf("  /** %s */\n", f.Comment))
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s", f.Name, f.Type))
+		if i < len(fields)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+		if opt.ExtraSpacing && i < len(fields)-1 {
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString(")")
+
+	if opt.CirceCodec {
+		if opt.Scala3 {
+			if opt.SnakeCaseKeys {
+				sb.WriteString(" derives ConfiguredCodec\n\n")
+				sb.WriteString(fmt.Sprintf("object %s:\n", className))
+				sb.WriteString("  given Configuration = Configuration.default.withSnakeCaseMemberNames\n")
+			} else {
+				sb.WriteString(" derives Codec.AsObject\n")
+			}
+		} else {
+			sb.WriteString("\n\n")
+			sb.WriteString(fmt.Sprintf("object %s {\n", className))
+			if opt.SnakeCaseKeys {
+				sb.WriteString("  implicit val config: Configuration = Configuration.default.withSnakeCaseMemberNames\n")
+				sb.WriteString(fmt.Sprintf(
+					"  implicit val codec: Codec.AsObject[%s] = deriveConfiguredCodec[%s]\n",
+					className, className,
+				))
+			} else {
+				sb.WriteString(fmt.Sprintf(
+					"  implicit val codec: Codec.AsObject[%s] = deriveCodec[%s]\n",
+					className, className,
+				))
+			}
+			sb.WriteString("}\n")
+		}
+	} else {
+		sb.WriteString("\n")
+	}
+
+	if opt.SlickTable {
+		tableClass := className + "Table"
+
+		var primaryKeys []string
+		for _, f := range fields {
+			if f.PrimaryKey {
+				primaryKeys = append(primaryKeys, f.Name)
+			}
+		}
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf(
+			"class %s(tag: Tag) extends Table[%s](tag, \"%s\") {\n",
+			tableClass, className, tbN,
+		))
+
+		var names []string
+		for _, f := range fields {
+			columnOpts := ""
+			if f.PrimaryKey && len(primaryKeys) == 1 {
+				columnOpts += ", O.PrimaryKey"
+			}
+			if f.MaxLength.Valid && f.MaxLength.Int16 > 0 && f.BaseType == "String" {
+				columnOpts += fmt.Sprintf(", O.Length(%d)", f.MaxLength.Int16)
+			}
+			sb.WriteString(fmt.Sprintf(
+				"  def %s = column[%s](\"%s\"%s)\n",
+				f.Name, f.Type, f.Column, columnOpts,
+			))
+			names = append(names, f.Name)
+		}
+
+		if len(primaryKeys) > 1 {
+			sb.WriteString(fmt.Sprintf(
+				"\n  def pk = primaryKey(\"pk_%s\", (%s))\n",
+				tbN, strings.Join(primaryKeys, ", "),
+			))
+		}
+
+		sb.WriteString(fmt.Sprintf("\n  def * = (%s).mapTo[%s]\n", strings.Join(names, ", "), className))
+		sb.WriteString("}\n\n")
+
+		// Scala 2 has no top-level definitions, so the query lives in the
+		// table's companion object there.
+		query := fmt.Sprintf("lazy val %s = TableQuery[%s]\n", escapeIdentifier(common.ToCamelCase(tbN)), tableClass)
+		if opt.Scala3 {
+			sb.WriteString(query)
+		} else {
+			sb.WriteString(fmt.Sprintf("object %s {\n  %s}\n", tableClass, query))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func slickProfile(dbType string) string {
+	switch strings.ToLower(dbType) {
+	case "postgres":
+		return "PostgresProfile"
+	case "mssql":
+		return "SQLServerProfile"
+	default:
+		return "MySQLProfile"
+	}
+}
+
+var scalaKeywords = map[string]bool{
+	"abstract": true, "case": true, "catch": true, "class": true, "def": true,
+	"do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "final": true, "finally": true, "for": true, "given": true,
+	"if": true, "implicit": true, "import": true, "lazy": true, "match": true,
+	"new": true, "null": true, "object": true, "override": true, "package": true,
+	"private": true, "protected": true, "return": true, "sealed": true, "super": true,
+	"then": true, "this": true, "throw": true, "trait": true, "true": true,
+	"try": true, "type": true, "val": true, "var": true, "while": true,
+	"with": true, "yield": true,
+}
+
+func escapeIdentifier(name string) string {
+	if scalaKeywords[name] {
+		return "`" + name + "`"
+	}
+	return name
+}
+
+func mapMySQLToScalaType(mysqlType string) string {
+	switch strings.ToLower(mysqlType) {
+
+	case "int", "integer", "mediumint":
+		return "Int"
+	case "bigint":
+		return "Long"
+	case "smallint":
+		return "Short"
+	case "tinyint":
+		return "Byte"
+	case "decimal", "numeric":
+		return "BigDecimal"
+	case "float":
+		return "Float"
+	case "double":
+		return "Double"
+
+	case "varchar", "char", "text", "longtext", "mediumtext", "tinytext", "enum", "json":
+		return "String"
+
+	case "date":
+		return "java.time.LocalDate"
+	case "datetime", "timestamp":
+		return "java.time.LocalDateTime"
+	case "time":
+		return "java.time.LocalTime"
+	case "year":
+		return "Int"
+
+	case "boolean", "bit":
+		return "Boolean"
+
+	case "blob", "longblob", "mediumblob", "tinyblob", "binary", "varbinary":
+		return "Array[Byte]"
+
+	default:
+		return "Any"
+	}
+}
+
+func mapPostgresToScalaType(pgType string) string {
+	switch strings.ToLower(pgType) {
+
+	case "smallint", "int2":
+		return "Short"
+	case "integer", "int", "int4", "serial":
+		return "Int"
+	case "bigint", "int8", "bigserial":
+		return "Long"
+	case "decimal", "numeric", "money":
+		return "BigDecimal"
+	case "real", "float4":
+		return "Float"
+	case "double precision", "float8":
+		return "Double"
+
+	case "varchar", "character varying", "char", "character", "bpchar", "text", "citext",
+		"json", "jsonb", "xml", "inet", "cidr", "macaddr":
+		return "String"
+
+	case "date":
+		return "java.time.LocalDate"
+	case "time", "time without time zone":
+		return "java.time.LocalTime"
+	case "timestamp", "timestamp without time zone":
+		return "java.time.LocalDateTime"
+	case "timestamptz", "timestamp with time zone":
+		return "java.time.OffsetDateTime"
+
+	case "boolean", "bool":
+		return "Boolean"
+
+	case "bytea":
+		return "Array[Byte]"
+
+	case "uuid":
+		return "java.util.UUID"
+
+	case "_int4", "integer[]":
+		return "List[Int]"
+	case "_int8", "bigint[]":
+		return "List[Long]"
+	case "_text", "text[]":
+		return "List[String]"
+	case "_uuid", "uuid[]":
+		return "List[java.util.UUID]"
+
+	default:
+		return "Any"
+	}
+}
+
+func mapMSSQLToScalaType(mssqlType string) string {
+	switch strings.ToLower(mssqlType) {
+
+	case "int":
+		return "Int"
+	case "bigint":
+		return "Long"
+	case "smallint", "tinyint":
+		return "Short"
+
+	case "decimal", "numeric", "money", "smallmoney":
+		return "BigDecimal"
+	case "float":
+		return "Double"
+	case "real":
+		return "Float"
+
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext", "xml":
+		return "String"
+
+	case "date":
+		return "java.time.LocalDate"
+	case "time":
+		return "java.time.LocalTime"
+	case "datetime", "datetime2", "smalldatetime":
+		return "java.time.LocalDateTime"
+	case "datetimeoffset":
+		return "java.time.OffsetDateTime"
+
+	case "bit":
+		return "Boolean"
+
+	case "binary", "varbinary", "image", "rowversion", "timestamp":
+		return "Array[Byte]"
+
+	case "uniqueidentifier":
+		return "java.util.UUID"
+
+	default:
+		return "Any"
+	}
+}
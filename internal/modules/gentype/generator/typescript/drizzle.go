@@ -0,0 +1,345 @@
+package typescript
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// Drizzle writes table definitions with the core builders of the connection's
+// dialect. Tables are collected by Generate and rendered by Footer so the
+// import list only names the builders that were used.
+type Drizzle struct {
+	builders  []string
+	relations bool
+	enums     []string
+	enumNames []string
+	tables    []string
+}
+
+type drizzleDialect struct {
+	Module    string
+	Table     string
+	AnyColumn string
+}
+
+func dialectFor(dbType string) (drizzleDialect, error) {
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return drizzleDialect{Module: "drizzle-orm/mysql-core", Table: "mysqlTable", AnyColumn: "AnyMySqlColumn"}, nil
+	case "postgres":
+		return drizzleDialect{Module: "drizzle-orm/pg-core", Table: "pgTable", AnyColumn: "AnyPgColumn"}, nil
+	case "mssql":
+		return drizzleDialect{Module: "drizzle-orm/mssql-core", Table: "mssqlTable", AnyColumn: "AnyMsSqlColumn"}, nil
+	default:
+		return drizzleDialect{}, fmt.Errorf("unsupported drizzle dialect: %s", dbType)
+	}
+}
+
+func (d *Drizzle) use(builder string) {
+	if !slices.Contains(d.builders, builder) {
+		d.builders = append(d.builders, builder)
+	}
+}
+
+func (d *Drizzle) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	dialect, err := dialectFor(dbType)
+	if err != nil {
+		return "", err
+	}
+
+	var opt domain.TypeScriptOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Drizzle Options", fmt.Errorf("invalid TypeScript options: %w", err)
+	}
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+	tableVar := lowerFirst(typeName)
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	var properties, primaryKeys []string
+	for _, col := range columns {
+		properties = append(properties, common.ToCamelCase(col.ColumnName))
+		if strings.Contains(col.ColumnKey, "PRI") {
+			primaryKeys = append(primaryKeys, common.ToCamelCase(col.ColumnName))
+		}
+	}
+
+	type relation struct {
+		Name   string
+		Target string
+		Field  string
+		Ref    string
+	}
+	var relations []relation
+
+	d.use(dialect.Table)
+	sb.WriteString(fmt.Sprintf("export const %s = %s(%s, {\n", tableVar, dialect.Table, quoteTS(tbN)))
+
+	for i, col := range columns {
+		property := properties[i]
+
+		if i > 0 && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("  /** %s */\n", strings.TrimSpace(col.ColumnComment.String)))
+		}
+
+		builder := d.columnBuilder(col, dbType)
+
+		var modifiers []string
+		primaryKey := strings.Contains(col.ColumnKey, "PRI")
+		if primaryKey && len(primaryKeys) == 1 {
+			modifiers = append(modifiers, ".primaryKey()")
+		} else if !strings.EqualFold(col.IsNullable, "YES") {
+			modifiers = append(modifiers, ".notNull()")
+		}
+
+		if col.IsIdentity == "YES" {
+			switch strings.ToLower(dbType) {
+			case "mysql":
+				modifiers = append(modifiers, ".autoincrement()")
+			case "mssql":
+				modifiers = append(modifiers, ".identity()")
+			}
+		}
+
+		if target, ok := common.BundledReference(col, req); ok {
+			targetVar := lowerFirst(req.Prefix + common.ToPascalCase(target) + req.Suffix)
+			ref := common.ToCamelCase(col.ReferencedColumn.String)
+
+			// A table referencing itself needs an explicit return type.
+			if targetVar == tableVar {
+				d.use("type " + dialect.AnyColumn)
+				modifiers = append(modifiers, fmt.Sprintf(".references((): %s => %s.%s)", dialect.AnyColumn, targetVar, ref))
+			} else {
+				modifiers = append(modifiers, fmt.Sprintf(".references(() => %s.%s)", targetVar, ref))
+			}
+
+			name := relationProperty(property, target, properties)
+			properties = append(properties, name)
+			relations = append(relations, relation{Name: name, Target: targetVar, Field: property, Ref: ref})
+		}
+
+		sb.WriteString(fmt.Sprintf("  %s: %s%s,\n", property, builder, strings.Join(modifiers, "")))
+	}
+
+	if len(primaryKeys) > 1 {
+		d.use("primaryKey")
+		var keys []string
+		for _, key := range primaryKeys {
+			keys = append(keys, "table."+key)
+		}
+		sb.WriteString(fmt.Sprintf("}, (table) => [primaryKey({ columns: [%s] })]);\n", strings.Join(keys, ", ")))
+	} else {
+		sb.WriteString("});\n")
+	}
+
+	if len(relations) > 0 {
+		d.relations = true
+		sb.WriteString(fmt.Sprintf("\nexport const %sRelations = relations(%s, ({ one }) => ({\n", tableVar, tableVar))
+		for _, r := range relations {
+			sb.WriteString(fmt.Sprintf(
+				"  %s: one(%s, { fields: [%s.%s], references: [%s.%s] }),\n",
+				r.Name, r.Target, tableVar, r.Field, r.Target, r.Ref,
+			))
+		}
+		sb.WriteString("}));\n")
+	}
+
+	if opt.ExportAllTypes {
+		sb.WriteString(fmt.Sprintf("\nexport type %s = typeof %s.$inferSelect;\n", typeName, tableVar))
+		sb.WriteString(fmt.Sprintf("export type New%s = typeof %s.$inferInsert;\n", typeName, tableVar))
+	}
+
+	d.tables = append(d.tables, sb.String())
+
+	return "", nil
+}
+
+func (d *Drizzle) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	dialect, err := dialectFor(dbType)
+	if err != nil {
+		return "", err
+	}
+
+	if d.relations {
+		sb.WriteString("import { relations } from 'drizzle-orm';\n")
+	}
+	sb.WriteString(fmt.Sprintf("import { %s } from '%s';\n", strings.Join(d.builders, ", "), dialect.Module))
+
+	if len(d.enums) > 0 {
+		sb.WriteString("\n")
+		for _, e := range d.enums {
+			sb.WriteString(e)
+		}
+	}
+
+	for _, table := range d.tables {
+		sb.WriteString("\n")
+		sb.WriteString(table)
+	}
+
+	return sb.String(), nil
+}
+
+// columnBuilder renders the builder call for a column. Types without a
+// builder fall back to the dialect's text column.
+func (d *Drizzle) columnBuilder(col domain.SqlData, dbType string) string {
+	name := quoteTS(col.ColumnName)
+
+	call := func(builder string, config ...string) string {
+		d.use(builder)
+		if len(config) == 0 || config[0] == "" {
+			return fmt.Sprintf("%s(%s)", builder, name)
+		}
+		return fmt.Sprintf("%s(%s, { %s })", builder, name, strings.Join(config, ", "))
+	}
+
+	length := ""
+	if col.CharacterMaximumLength.Valid {
+		switch n := col.CharacterMaximumLength.Int16; {
+		case n < 0:
+			length = "length: 'max'"
+		case n > 0:
+			length = fmt.Sprintf("length: %d", n)
+		}
+	}
+
+	precision := ""
+	if col.NumericPrecision.Valid && col.NumericPrecision.Int16 > 0 {
+		precision = fmt.Sprintf("precision: %d, scale: %d", col.NumericPrecision.Int16, col.NumericScale.Int16)
+	}
+
+	values := common.EnumValues(col)
+	var literals []string
+	for _, value := range values {
+		literals = append(literals, quoteTS(value))
+	}
+
+	dataType := strings.ToLower(col.DataType)
+	identity := col.IsIdentity == "YES"
+
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		if len(values) > 0 {
+			d.use("mysqlEnum")
+			return fmt.Sprintf("mysqlEnum(%s, [%s])", name, strings.Join(literals, ", "))
+		}
+		switch dataType {
+		case "tinyint", "smallint", "mediumint", "int", "float", "double", "year",
+			"date", "datetime", "timestamp", "time", "json", "boolean",
+			"text", "tinytext", "mediumtext", "longtext":
+			return call(dataType)
+		case "integer":
+			return call("int")
+		case "bigint":
+			return call("bigint", "mode: 'number'")
+		case "decimal", "numeric":
+			return call("decimal", precision)
+		case "varchar", "char", "binary", "varbinary":
+			return call(dataType, length)
+		case "bit", "bool":
+			return call("boolean")
+		default:
+			return call("text")
+		}
+
+	case "postgres":
+		if len(values) > 0 {
+			enumVar := common.ToCamelCase(col.DataType) + "Enum"
+			if !slices.Contains(d.enumNames, enumVar) {
+				d.use("pgEnum")
+				d.enumNames = append(d.enumNames, enumVar)
+				d.enums = append(d.enums, fmt.Sprintf("export const %s = pgEnum(%s, [%s]);\n", enumVar, quoteTS(col.DataType), strings.Join(literals, ", ")))
+			}
+			return fmt.Sprintf("%s(%s)", enumVar, name)
+		}
+
+		if strings.HasPrefix(dataType, "_") {
+			element := col
+			element.DataType = strings.TrimPrefix(dataType, "_")
+			element.IsIdentity = "NO"
+			return d.columnBuilder(element, dbType) + ".array()"
+		}
+
+		switch dataType {
+		case "int2":
+			if identity {
+				return call("smallserial")
+			}
+			return call("smallint")
+		case "int4":
+			if identity {
+				return call("serial")
+			}
+			return call("integer")
+		case "int8":
+			if identity {
+				return call("bigserial", "mode: 'number'")
+			}
+			return call("bigint", "mode: 'number'")
+		case "numeric":
+			return call("numeric", precision)
+		case "float4":
+			return call("real")
+		case "float8":
+			return call("doublePrecision")
+		case "varchar":
+			return call("varchar", length)
+		case "bpchar":
+			return call("char", length)
+		case "text", "uuid", "boolean", "date", "time", "json", "jsonb", "inet", "cidr", "macaddr", "interval":
+			return call(dataType)
+		case "bool":
+			return call("boolean")
+		case "timestamp":
+			return call("timestamp")
+		case "timestamptz":
+			return call("timestamp", "withTimezone: true")
+		case "timetz":
+			return call("time", "withTimezone: true")
+		default:
+			return call("text")
+		}
+
+	case "mssql":
+		switch dataType {
+		case "int", "smallint", "tinyint", "bit", "float", "real",
+			"date", "datetime", "datetime2", "datetimeoffset", "time", "text", "ntext":
+			return call(dataType)
+		case "bigint":
+			return call("bigint", "mode: 'number'")
+		case "decimal", "numeric":
+			return call(dataType, precision)
+		case "char", "nchar", "varchar", "nvarchar", "binary", "varbinary":
+			return call(dataType, length)
+		default:
+			return call("nvarchar")
+		}
+	}
+
+	return call("text")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -41,37 +41,21 @@ func (r *Dto) Generate(rows *sql.Rows, info domain.TypeRequest, tbN string, dbTy
 		sb.WriteString(fmt.Sprintf(" %s type %s = {\n", export, tableName))
 	}
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		)
-		if err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
+	for _, col := range columns {
 		var tsType string
 
 		switch strings.ToLower(dbType) {
 		case "mysql":
-			tsType = mapMySQLToTSType(dataType)
+			tsType = mapMySQLToTSType(col.DataType)
 		case "postgres":
-			tsType = mapPostgresqlToTSType(dataType)
+			tsType = mapPostgresqlToTSType(col.DataType)
 		case "mssql", "sqlserver", "sql_server":
-			tsType = mapMSSQLToTSType(dataType)
+			tsType = mapMSSQLToTSType(col.DataType)
 		default:
 			tsType = "any"
 		}
@@ -80,12 +64,12 @@ func (r *Dto) Generate(rows *sql.Rows, info domain.TypeRequest, tbN string, dbTy
 		if opt.OptionalProperties {
 			optional = "?"
 		} else {
-			if isNullable == "YES" {
+			if col.IsNullable == "YES" {
 				optional = "?"
 			}
 		}
 
-		fieldName := common.ToCamelCase(columnName)
+		fieldName := common.ToCamelCase(col.ColumnName)
 
 		readonly := ""
 		if opt.ReadonlyProperties {
@@ -97,10 +81,10 @@ func (r *Dto) Generate(rows *sql.Rows, info domain.TypeRequest, tbN string, dbTy
 		}
 
 		if opt.Comments {
-			if columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
+			if col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
 				sb.WriteString(fmt.Sprintf(
 					"  /** %s */\n",
-					columnComment.String,
+					col.ColumnComment.String,
 				))
 			}
 		}
@@ -0,0 +1,122 @@
+package typescript
+
+import (
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// columnKind maps a column to a library neutral kind and, for arrays, the
+// element kind. Zod and the other validator styles render from it. Any
+// column with enum values is an enum, whatever its database type is called.
+func columnKind(dbType string, col domain.SqlData) (string, string) {
+	if len(common.EnumValues(col)) > 0 {
+		return "enum", ""
+	}
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return mysqlKind(col.DataType)
+	case "postgres":
+		return postgresKind(col.DataType)
+	case "mssql":
+		return mssqlKind(col.DataType)
+	default:
+		return "any", ""
+	}
+}
+
+func mysqlKind(mysqlType string) (string, string) {
+	switch strings.ToLower(mysqlType) {
+
+	case "int", "bigint", "smallint", "tinyint", "decimal", "float", "double", "long":
+		return "number", ""
+
+	case "varchar", "char", "text", "longtext":
+		return "string", ""
+
+	case "datetime", "timestamp", "date":
+		return "date", ""
+
+	case "boolean", "bit":
+		return "boolean", ""
+
+	default:
+		return "string", ""
+	}
+}
+
+func postgresKind(pgType string) (string, string) {
+	switch strings.ToLower(pgType) {
+
+	case "smallint", "int2",
+		"integer", "int", "int4", "serial",
+		"bigint", "int8", "bigserial",
+		"decimal", "numeric", "money",
+		"real", "float4",
+		"double precision", "float8":
+		return "number", ""
+
+	case "varchar", "character varying",
+		"char", "character",
+		"text", "citext",
+		"inet", "cidr", "macaddr",
+		"xml":
+		return "string", ""
+
+	case "boolean", "bool":
+		return "boolean", ""
+
+	case "date",
+		"timestamp", "timestamp without time zone",
+		"timestamptz", "timestamp with time zone",
+		"time", "time without time zone":
+		return "date", ""
+
+	case "uuid":
+		return "uuid", ""
+
+	case "json", "jsonb":
+		return "any", ""
+
+	case "bytea":
+		return "bytes", ""
+
+	case "integer[]", "_int4":
+		return "array", "number"
+	case "bigint[]", "_int8":
+		return "array", "number"
+	case "text[]", "_text":
+		return "array", "string"
+	case "uuid[]", "_uuid":
+		return "array", "uuid"
+
+	default:
+		return "string", ""
+	}
+}
+
+func mssqlKind(mssqlType string) (string, string) {
+	switch strings.ToLower(mssqlType) {
+	case "int", "bigint", "smallint", "tinyint",
+		"decimal", "numeric", "float", "real",
+		"money", "smallmoney":
+		return "number", ""
+	case "varchar", "nvarchar", "char", "nchar", "text", "ntext":
+		return "string", ""
+	case "datetime", "datetime2", "smalldatetime", "date", "time", "datetimeoffset":
+		return "date", ""
+	case "bit":
+		return "boolean", ""
+	case "binary", "varbinary", "image", "rowversion", "timestamp":
+		return "bytes", ""
+	case "uniqueidentifier":
+		return "uuid", ""
+	case "xml":
+		return "string", ""
+	case "sql_variant", "hierarchyid", "geometry", "geography":
+		return "any", ""
+	default:
+		return "string", ""
+	}
+}
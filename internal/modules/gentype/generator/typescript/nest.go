@@ -0,0 +1,221 @@
+package typescript
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// NestDto writes NestJS DTO classes decorated for class-validator and
+// class-transformer. Classes are collected by Generate and rendered by Footer
+// so each package is imported once with only the decorators in use.
+type NestDto struct {
+	validators  []string
+	transformer bool
+	swagger     []string
+	classes     []string
+}
+
+var integerTypes = []string{
+	"tinyint", "smallint", "mediumint", "int", "integer", "bigint", "year",
+	"int2", "int4", "int8", "serial", "bigserial", "smallserial",
+}
+
+func (n *NestDto) validator(decorator string) string {
+	name := decorator[:strings.Index(decorator, "(")]
+	if !slices.Contains(n.validators, name) {
+		n.validators = append(n.validators, name)
+	}
+	return "@" + decorator
+}
+
+func (n *NestDto) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.NestDtoOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid NestJS Options", fmt.Errorf("invalid NestJS options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(fmt.Sprintf("export class %s {\n", className))
+
+	for i, col := range columns {
+		kind, items := columnKind(dbType, col)
+		values := common.EnumValues(col)
+
+		nullable := strings.EqualFold(col.IsNullable, "YES")
+		optional := nullable || opt.OptionalProperties
+
+		maxLength := 0
+		if kind == "string" && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+			maxLength = int(col.CharacterMaximumLength.Int16)
+		}
+
+		var decorators []string
+		var tsType string
+
+		if opt.Swagger {
+			decorators = append(decorators, n.apiProperty(col, optional, nullable, maxLength, values))
+		}
+
+		if optional {
+			decorators = append(decorators, n.validator("IsOptional()"))
+		}
+
+		each := ""
+		if kind == "array" {
+			decorators = append(decorators, n.validator("IsArray()"))
+			kind = items
+			each = "{ each: true }"
+		}
+
+		switch kind {
+		case "number":
+			tsType = "number"
+			if slices.Contains(integerTypes, strings.ToLower(col.DataType)) {
+				decorators = append(decorators, n.validator(fmt.Sprintf("IsInt(%s)", each)))
+			} else if each != "" {
+				decorators = append(decorators, n.validator(fmt.Sprintf("IsNumber({}, %s)", each)))
+			} else {
+				decorators = append(decorators, n.validator("IsNumber()"))
+			}
+		case "string":
+			tsType = "string"
+			decorators = append(decorators, n.validator(fmt.Sprintf("IsString(%s)", each)))
+			if maxLength > 0 {
+				decorators = append(decorators, n.validator(fmt.Sprintf("MaxLength(%d)", maxLength)))
+			}
+		case "uuid":
+			tsType = "string"
+			if each != "" {
+				decorators = append(decorators, n.validator(fmt.Sprintf("IsUUID(undefined, %s)", each)))
+			} else {
+				decorators = append(decorators, n.validator("IsUUID()"))
+			}
+		case "boolean":
+			tsType = "boolean"
+			decorators = append(decorators, n.validator("IsBoolean()"))
+		case "date":
+			// Dates arrive as ISO strings unless they are transformed into Date objects.
+			if opt.DateObjects {
+				tsType = "Date"
+				n.transformer = true
+				decorators = append(decorators, "@Type(() => Date)", n.validator("IsDate()"))
+			} else {
+				tsType = "string"
+				decorators = append(decorators, n.validator("IsDateString()"))
+			}
+		case "enum":
+			var literals []string
+			for _, value := range values {
+				literals = append(literals, quoteTS(value))
+			}
+			tsType = strings.Join(literals, " | ")
+			decorators = append(decorators, n.validator(fmt.Sprintf("IsIn([%s])", strings.Join(literals, ", "))))
+		case "bytes":
+			tsType = "Uint8Array"
+		default:
+			tsType = "any"
+		}
+
+		if each != "" {
+			tsType += "[]"
+		}
+		if nullable {
+			tsType += " | null"
+		}
+
+		// Decorated properties are always separated, as in NestJS sources.
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		for _, decorator := range decorators {
+			sb.WriteString(fmt.Sprintf("  %s\n", decorator))
+		}
+
+		readonly := ""
+		if opt.ReadonlyProperties {
+			readonly = "readonly "
+		}
+		marker := "!"
+		if optional {
+			marker = "?"
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s%s: %s;\n", readonly, common.ToCamelCase(col.ColumnName), marker, tsType))
+	}
+
+	sb.WriteString("}\n")
+
+	n.classes = append(n.classes, sb.String())
+
+	return "", nil
+}
+
+func (n *NestDto) apiProperty(col domain.SqlData, optional, nullable bool, maxLength int, values []string) string {
+	decorator := "ApiProperty"
+	if optional {
+		decorator = "ApiPropertyOptional"
+	}
+	if !slices.Contains(n.swagger, decorator) {
+		n.swagger = append(n.swagger, decorator)
+	}
+
+	var options []string
+	if col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+		options = append(options, fmt.Sprintf("description: %s", quoteTS(strings.TrimSpace(col.ColumnComment.String))))
+	}
+	if nullable {
+		options = append(options, "nullable: true")
+	}
+	if maxLength > 0 {
+		options = append(options, fmt.Sprintf("maxLength: %d", maxLength))
+	}
+	if len(values) > 0 {
+		var literals []string
+		for _, value := range values {
+			literals = append(literals, quoteTS(value))
+		}
+		options = append(options, fmt.Sprintf("enum: [%s]", strings.Join(literals, ", ")))
+	}
+
+	if len(options) == 0 {
+		return fmt.Sprintf("@%s()", decorator)
+	}
+	return fmt.Sprintf("@%s({ %s })", decorator, strings.Join(options, ", "))
+}
+
+func (n *NestDto) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	if len(n.swagger) > 0 {
+		slices.Sort(n.swagger)
+		sb.WriteString(fmt.Sprintf("import { %s } from '@nestjs/swagger';\n", strings.Join(n.swagger, ", ")))
+	}
+	if n.transformer {
+		sb.WriteString("import { Type } from 'class-transformer';\n")
+	}
+	if len(n.validators) > 0 {
+		slices.Sort(n.validators)
+		sb.WriteString(fmt.Sprintf("import { %s } from 'class-validator';\n", strings.Join(n.validators, ", ")))
+	}
+
+	for _, class := range n.classes {
+		sb.WriteString("\n")
+		sb.WriteString(class)
+	}
+
+	return sb.String(), nil
+}
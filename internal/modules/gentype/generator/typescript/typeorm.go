@@ -0,0 +1,328 @@
+package typescript
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// TypeOrm writes TypeORM entities. Relations between tables in the same
+// request are declared on both sides, so entities are collected by Generate
+// and rendered by Footer, which also imports only the decorators used.
+type TypeOrm struct {
+	decorators []string
+	entities   []*typeOrmEntity
+}
+
+type typeOrmEntity struct {
+	ClassName  string
+	Table      string
+	Columns    string
+	Properties []string
+	Relations  []*typeOrmRelation
+	Inverse    []*typeOrmRelation
+}
+
+type typeOrmRelation struct {
+	Property   string
+	Inverse    string
+	Column     string
+	RefColumn  string
+	TargetName string
+	Source     *typeOrmEntity
+	Target     *typeOrmEntity
+	OneToOne   bool
+	Nullable   bool
+}
+
+func (t *TypeOrm) use(decorator string) {
+	if !slices.Contains(t.decorators, decorator) {
+		t.decorators = append(t.decorators, decorator)
+	}
+}
+
+func (t *TypeOrm) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	className := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	var opt domain.TypeScriptOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid TypeORM Options", fmt.Errorf("invalid TypeScript options: %w", err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
+
+	en := &typeOrmEntity{ClassName: className, Table: tbN}
+	for _, col := range columns {
+		en.Properties = append(en.Properties, common.ToCamelCase(col.ColumnName))
+	}
+
+	t.use("Entity")
+	sb.WriteString(fmt.Sprintf("@Entity({ name: %s })\n", quoteTS(tbN)))
+	sb.WriteString(fmt.Sprintf("export class %s {\n", className))
+
+	for i, col := range columns {
+		property := en.Properties[i]
+		nullable := strings.EqualFold(col.IsNullable, "YES")
+		primaryKey := strings.Contains(col.ColumnKey, "PRI")
+
+		if i > 0 && opt.ExtraSpacing {
+			sb.WriteString("\n")
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf("  /** %s */\n", strings.TrimSpace(col.ColumnComment.String)))
+		}
+
+		columnOptions := typeOrmColumnOptions(col, dbType)
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			columnOptions = append(columnOptions, fmt.Sprintf("comment: %s", quoteTS(strings.TrimSpace(col.ColumnComment.String))))
+		}
+
+		switch {
+		case primaryKey && col.IsIdentity == "YES":
+			t.use("PrimaryGeneratedColumn")
+			sb.WriteString(fmt.Sprintf("  @PrimaryGeneratedColumn({ %s })\n", strings.Join(columnOptions, ", ")))
+		case primaryKey:
+			t.use("PrimaryColumn")
+			sb.WriteString(fmt.Sprintf("  @PrimaryColumn({ %s })\n", strings.Join(columnOptions, ", ")))
+		default:
+			if nullable {
+				columnOptions = append(columnOptions, "nullable: true")
+			}
+			// Computed columns are read back but never written.
+			if col.IsGenerated == "YES" {
+				columnOptions = append(columnOptions, "insert: false", "update: false")
+			}
+			t.use("Column")
+			sb.WriteString(fmt.Sprintf("  @Column({ %s })\n", strings.Join(columnOptions, ", ")))
+		}
+
+		tsType := mapToTSType(dbType, col.DataType)
+		if values := common.EnumValues(col); len(values) > 0 {
+			var literals []string
+			for _, value := range values {
+				literals = append(literals, quoteTS(value))
+			}
+			tsType = strings.Join(literals, " | ")
+		}
+		if nullable {
+			tsType += " | null"
+		}
+
+		readonly := ""
+		if opt.ReadonlyProperties {
+			readonly = "readonly "
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s!: %s;\n", readonly, property, tsType))
+
+		if target, ok := common.BundledReference(col, req); ok {
+			relation := relationProperty(property, target, en.Properties)
+			en.Properties = append(en.Properties, relation)
+			en.Relations = append(en.Relations, &typeOrmRelation{
+				Property:   relation,
+				Column:     col.ColumnName,
+				RefColumn:  col.ReferencedColumn.String,
+				TargetName: target,
+				Source:     en,
+				OneToOne:   strings.Contains(col.ColumnKey, "UNI"),
+				Nullable:   nullable,
+			})
+		}
+	}
+
+	en.Columns = sb.String()
+	t.entities = append(t.entities, en)
+
+	return "", nil
+}
+
+func (t *TypeOrm) Footer(req domain.TypeRequest, dbType string) (string, error) {
+	t.resolveRelations()
+
+	var body strings.Builder
+	for i, en := range t.entities {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		t.writeEntity(&body, en)
+	}
+
+	var sb strings.Builder
+	slices.Sort(t.decorators)
+	sb.WriteString(fmt.Sprintf("import { %s } from 'typeorm';\n\n", strings.Join(t.decorators, ", ")))
+	sb.WriteString(body.String())
+
+	return sb.String(), nil
+}
+
+// resolveRelations links each relation to its target entity and names the
+// inverse property there, after the source table.
+func (t *TypeOrm) resolveRelations() {
+	for _, en := range t.entities {
+		for _, r := range en.Relations {
+			i := slices.IndexFunc(t.entities, func(target *typeOrmEntity) bool {
+				return strings.EqualFold(target.Table, r.TargetName)
+			})
+			r.Target = t.entities[i]
+		}
+	}
+
+	for _, en := range t.entities {
+		for _, r := range en.Relations {
+			inverse := common.ToCamelCase(en.Table)
+			for _, other := range en.Relations {
+				if other.Target == r.Target && other != r {
+					inverse += common.ToPascalCase(r.Property)
+					break
+				}
+			}
+			r.Inverse = uniqueProperty(inverse, r.Target.Properties)
+			r.Target.Properties = append(r.Target.Properties, r.Inverse)
+			r.Target.Inverse = append(r.Target.Inverse, r)
+		}
+	}
+}
+
+// writeEntity closes the entity's columns with its relations: the owning
+// side of each foreign key, then the inverse side of those pointing at it.
+func (t *TypeOrm) writeEntity(sb *strings.Builder, en *typeOrmEntity) {
+	sb.WriteString(en.Columns)
+
+	for _, r := range en.Relations {
+		decorator := "ManyToOne"
+		if r.OneToOne {
+			decorator = "OneToOne"
+		}
+		t.use(decorator)
+		t.use("JoinColumn")
+
+		param := lowerFirst(r.Target.ClassName)
+		options := ""
+		if r.Nullable {
+			options = ", { nullable: true }"
+		}
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("  @%s(() => %s, (%s) => %s.%s%s)\n",
+			decorator, r.Target.ClassName, param, param, r.Inverse, options))
+		sb.WriteString(fmt.Sprintf(
+			"  @JoinColumn({ name: %s, referencedColumnName: %s })\n",
+			quoteTS(r.Column),
+			quoteTS(common.ToCamelCase(r.RefColumn)),
+		))
+		sb.WriteString(fmt.Sprintf("  %s?: %s;\n", r.Property, r.Target.ClassName))
+	}
+
+	for _, r := range en.Inverse {
+		param := lowerFirst(r.Source.ClassName)
+
+		sb.WriteString("\n")
+		if r.OneToOne {
+			t.use("OneToOne")
+			sb.WriteString(fmt.Sprintf("  @OneToOne(() => %s, (%s) => %s.%s)\n", r.Source.ClassName, param, param, r.Property))
+			sb.WriteString(fmt.Sprintf("  %s?: %s;\n", r.Inverse, r.Source.ClassName))
+		} else {
+			t.use("OneToMany")
+			sb.WriteString(fmt.Sprintf("  @OneToMany(() => %s, (%s) => %s.%s)\n", r.Source.ClassName, param, param, r.Property))
+			sb.WriteString(fmt.Sprintf("  %s?: %s[];\n", r.Inverse, r.Source.ClassName))
+		}
+	}
+
+	sb.WriteString("}\n")
+}
+
+func typeOrmColumnOptions(col domain.SqlData, dbType string) []string {
+	dataType := strings.ToLower(col.DataType)
+	options := []string{fmt.Sprintf("name: %s", quoteTS(col.ColumnName))}
+
+	values := common.EnumValues(col)
+	switch {
+	case len(values) > 0:
+		var literals []string
+		for _, value := range values {
+			literals = append(literals, quoteTS(value))
+		}
+		options = append(options, "type: 'enum'", fmt.Sprintf("enum: [%s]", strings.Join(literals, ", ")))
+		if strings.EqualFold(dbType, "postgres") {
+			options = append(options, fmt.Sprintf("enumName: %s", quoteTS(col.DataType)))
+		}
+		return options
+	case strings.EqualFold(dbType, "postgres") && strings.HasPrefix(dataType, "_"):
+		options = append(options, fmt.Sprintf("type: %s", quoteTS(postgresTypeOrmType(strings.TrimPrefix(dataType, "_")))), "array: true")
+		return options
+	case strings.EqualFold(dbType, "postgres"):
+		options = append(options, fmt.Sprintf("type: %s", quoteTS(postgresTypeOrmType(dataType))))
+	default:
+		options = append(options, fmt.Sprintf("type: %s", quoteTS(dataType)))
+	}
+
+	if col.CharacterMaximumLength.Valid {
+		switch n := col.CharacterMaximumLength.Int16; {
+		case n < 0:
+			options = append(options, "length: 'max'")
+		case n > 0 && slices.Contains([]string{"varchar", "char", "bpchar", "nvarchar", "nchar", "binary", "varbinary"}, dataType):
+			options = append(options, fmt.Sprintf("length: %d", n))
+		}
+	}
+
+	if col.NumericPrecision.Valid && slices.Contains([]string{"decimal", "numeric"}, dataType) {
+		options = append(options, fmt.Sprintf("precision: %d", col.NumericPrecision.Int16))
+		if col.NumericScale.Valid {
+			options = append(options, fmt.Sprintf("scale: %d", col.NumericScale.Int16))
+		}
+	}
+
+	return options
+}
+
+// postgresTypeOrmType converts udt names TypeORM does not accept to their SQL spelling.
+func postgresTypeOrmType(udtName string) string {
+	switch udtName {
+	case "bpchar":
+		return "char"
+	default:
+		return udtName
+	}
+}
+
+func mapToTSType(dbType, dataType string) string {
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return mapMySQLToTSType(dataType)
+	case "postgres":
+		return mapPostgresqlToTSType(dataType)
+	case "mssql":
+		return mapMSSQLToTSType(dataType)
+	default:
+		return "any"
+	}
+}
+
+// relationProperty names the navigation property after its foreign key
+// (userId becomes user), falling back to the target table name.
+func relationProperty(property, target string, taken []string) string {
+	name := strings.TrimSuffix(property, "Id")
+	if name == "" || name == property {
+		name = common.ToCamelCase(target)
+	}
+	return uniqueProperty(name, taken)
+}
+
+// uniqueProperty numbers name until it differs from every taken property.
+func uniqueProperty(name string, taken []string) string {
+	candidate := name
+	for i := 2; slices.Contains(taken, candidate); i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	return candidate
+}
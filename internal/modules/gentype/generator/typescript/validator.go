@@ -0,0 +1,432 @@
+package typescript
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// validatorField holds the constraints the Zod generator applies to a column,
+// so every validator library can render the same rules.
+type validatorField struct {
+	Name     string
+	Kind     string
+	Items    string
+	Enum     []string
+	Trim     bool
+	Max      int
+	Nullable bool
+	Optional bool
+	Nullish  bool
+	Comment  string
+}
+
+func readValidatorFields(rows *sql.Rows, req domain.TypeRequest, dbType string) ([]validatorField, domain.ZodOptions, error) {
+	var opt domain.ZodOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return nil, opt, fmt.Errorf("invalid %s options: %w", req.Style, err)
+	}
+
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return nil, opt, err
+	}
+
+	var fields []validatorField
+	for _, col := range columns {
+		f := validatorField{Name: common.ToCamelCase(col.ColumnName)}
+		f.Kind, f.Items = columnKind(dbType, col)
+		if f.Kind == "enum" {
+			f.Enum = common.EnumValues(col)
+		}
+
+		if f.Kind == "string" {
+			f.Trim = opt.Trim
+			if opt.MaxValue && col.CharacterMaximumLength.Valid && col.CharacterMaximumLength.Int16 > 0 {
+				f.Max = int(col.CharacterMaximumLength.Int16)
+			}
+		}
+
+		if opt.Nullish {
+			f.Nullish = true
+		} else {
+			f.Nullable = opt.Nullable || col.IsNullable == "YES"
+			f.Optional = opt.AllOptional
+		}
+
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			f.Comment = strings.TrimSpace(col.ColumnComment.String)
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, opt, nil
+}
+
+func quoteTS(s string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", `\'`) + "'"
+}
+
+func writeFieldLine(sb *strings.Builder, key, expr string, f validatorField) {
+	sb.WriteString(fmt.Sprintf("  %s: %s,", key, expr))
+	if f.Comment != "" {
+		sb.WriteString(fmt.Sprintf(" // %s", f.Comment))
+	}
+	sb.WriteString("\n")
+}
+
+type Valibot struct{}
+
+func (v *Valibot) Header(req domain.TypeRequest, dbType string) (string, error) {
+	return "import * as v from 'valibot';\n\n", nil
+}
+
+func (v *Valibot) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	fields, opt, err := readValidatorFields(rows, req, dbType)
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(fmt.Sprintf("export const %sSchema = v.strictObject({\n", typeName))
+	for _, f := range fields {
+		expr := valibotBase(f.Kind, f.Items, f.Enum)
+
+		var pipe []string
+		if f.Trim {
+			pipe = append(pipe, "v.trim()")
+		}
+		if f.Max > 0 {
+			pipe = append(pipe, fmt.Sprintf("v.maxLength(%d)", f.Max))
+		}
+		if len(pipe) > 0 {
+			expr = fmt.Sprintf("v.pipe(%s, %s)", expr, strings.Join(pipe, ", "))
+		}
+
+		if f.Nullish {
+			expr = fmt.Sprintf("v.nullish(%s)", expr)
+		} else {
+			if f.Nullable {
+				expr = fmt.Sprintf("v.nullable(%s)", expr)
+			}
+			if f.Optional {
+				expr = fmt.Sprintf("v.optional(%s)", expr)
+			}
+		}
+
+		writeFieldLine(&sb, f.Name, expr, f)
+	}
+	sb.WriteString("});\n")
+
+	if opt.ExportAllTypes {
+		sb.WriteString(fmt.Sprintf("\nexport type %s = v.InferOutput<typeof %sSchema>;\n", typeName, typeName))
+	}
+
+	return sb.String(), nil
+}
+
+func valibotBase(kind, items string, enum []string) string {
+	switch kind {
+	case "string":
+		return "v.string()"
+	case "number":
+		return "v.number()"
+	case "date":
+		return "v.date()"
+	case "boolean":
+		return "v.boolean()"
+	case "uuid":
+		return "v.pipe(v.string(), v.uuid())"
+	case "bytes":
+		return "v.instance(Uint8Array)"
+	case "enum":
+		var values []string
+		for _, e := range enum {
+			values = append(values, quoteTS(e))
+		}
+		return fmt.Sprintf("v.picklist([%s])", strings.Join(values, ", "))
+	case "array":
+		return fmt.Sprintf("v.array(%s)", valibotBase(items, "", nil))
+	default:
+		return "v.any()"
+	}
+}
+
+type Yup struct{}
+
+func (y *Yup) Header(req domain.TypeRequest, dbType string) (string, error) {
+	return "import * as yup from 'yup';\n\n", nil
+}
+
+func (y *Yup) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	fields, opt, err := readValidatorFields(rows, req, dbType)
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(fmt.Sprintf("export const %sSchema = yup.object({\n", typeName))
+	for _, f := range fields {
+		expr := yupBase(f.Kind, f.Items, f.Enum)
+
+		if f.Trim {
+			expr += ".trim()"
+		}
+		if f.Max > 0 {
+			expr += fmt.Sprintf(".max(%d)", f.Max)
+		}
+
+		// Yup schemas accept undefined unless marked defined, which is the
+		// inverse of Zod, so presence is stated explicitly.
+		if f.Nullish {
+			expr += ".nullable().optional()"
+		} else {
+			if f.Nullable {
+				expr += ".nullable()"
+			}
+			if f.Optional {
+				expr += ".optional()"
+			} else {
+				expr += ".defined()"
+			}
+		}
+
+		writeFieldLine(&sb, f.Name, expr, f)
+	}
+	sb.WriteString("}).noUnknown();\n")
+
+	if opt.ExportAllTypes {
+		sb.WriteString(fmt.Sprintf("\nexport type %s = yup.InferType<typeof %sSchema>;\n", typeName, typeName))
+	}
+
+	return sb.String(), nil
+}
+
+func yupBase(kind, items string, enum []string) string {
+	switch kind {
+	case "string":
+		return "yup.string()"
+	case "number":
+		return "yup.number()"
+	case "date":
+		return "yup.date()"
+	case "boolean":
+		return "yup.boolean()"
+	case "uuid":
+		return "yup.string().uuid()"
+	case "bytes":
+		return "yup.mixed<Uint8Array>((value): value is Uint8Array => value instanceof Uint8Array)"
+	case "enum":
+		var values []string
+		for _, e := range enum {
+			values = append(values, quoteTS(e))
+		}
+		return fmt.Sprintf("yup.string().oneOf([%s] as const)", strings.Join(values, ", "))
+	case "array":
+		return fmt.Sprintf("yup.array(%s.defined())", yupBase(items, "", nil))
+	default:
+		return "yup.mixed()"
+	}
+}
+
+type IoTs struct{}
+
+func (i *IoTs) Header(req domain.TypeRequest, dbType string) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("import * as t from 'io-ts';\n\n")
+	sb.WriteString("const instanceOf = <A>(ctor: new (...args: any[]) => A, name: string) =>\n")
+	sb.WriteString("  new t.Type<A, A, unknown>(\n")
+	sb.WriteString("    name,\n")
+	sb.WriteString("    (u): u is A => u instanceof ctor,\n")
+	sb.WriteString("    (u, c) => (u instanceof ctor ? t.success(u) : t.failure(u, c)),\n")
+	sb.WriteString("    t.identity,\n")
+	sb.WriteString("  );\n\n")
+	sb.WriteString("const refinedString = (opts: { trim?: boolean; max?: number; pattern?: RegExp }) =>\n")
+	sb.WriteString("  new t.Type<string, string, unknown>(\n")
+	sb.WriteString("    'RefinedString',\n")
+	sb.WriteString("    (u): u is string =>\n")
+	sb.WriteString("      typeof u === 'string' &&\n")
+	sb.WriteString("      (opts.max === undefined || u.length <= opts.max) &&\n")
+	sb.WriteString("      (opts.pattern === undefined || opts.pattern.test(u)),\n")
+	sb.WriteString("    (u, c) => {\n")
+	sb.WriteString("      if (typeof u !== 'string') return t.failure(u, c);\n")
+	sb.WriteString("      const s = opts.trim ? u.trim() : u;\n")
+	sb.WriteString("      if (opts.max !== undefined && s.length > opts.max) return t.failure(u, c);\n")
+	sb.WriteString("      if (opts.pattern !== undefined && !opts.pattern.test(s)) return t.failure(u, c);\n")
+	sb.WriteString("      return t.success(s);\n")
+	sb.WriteString("    },\n")
+	sb.WriteString("    t.identity,\n")
+	sb.WriteString("  );\n\n")
+	sb.WriteString("const UUID_PATTERN = /^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$/i;\n\n")
+
+	return sb.String(), nil
+}
+
+func (i *IoTs) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	fields, opt, err := readValidatorFields(rows, req, dbType)
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(fmt.Sprintf("export const %sSchema = t.exact(t.type({\n", typeName))
+	for _, f := range fields {
+		var expr string
+		if f.Kind == "string" && (f.Trim || f.Max > 0) {
+			var refinements []string
+			if f.Trim {
+				refinements = append(refinements, "trim: true")
+			}
+			if f.Max > 0 {
+				refinements = append(refinements, fmt.Sprintf("max: %d", f.Max))
+			}
+			expr = fmt.Sprintf("refinedString({ %s })", strings.Join(refinements, ", "))
+		} else {
+			expr = ioTsBase(f.Kind, f.Items, f.Enum)
+		}
+
+		members := []string{expr}
+		if f.Nullish || f.Nullable {
+			members = append(members, "t.null")
+		}
+		if f.Nullish || f.Optional {
+			members = append(members, "t.undefined")
+		}
+		if len(members) > 1 {
+			expr = fmt.Sprintf("t.union([%s])", strings.Join(members, ", "))
+		}
+
+		writeFieldLine(&sb, f.Name, expr, f)
+	}
+	sb.WriteString("}));\n")
+
+	if opt.ExportAllTypes {
+		sb.WriteString(fmt.Sprintf("\nexport type %s = t.TypeOf<typeof %sSchema>;\n", typeName, typeName))
+	}
+
+	return sb.String(), nil
+}
+
+func ioTsBase(kind, items string, enum []string) string {
+	switch kind {
+	case "string":
+		return "t.string"
+	case "number":
+		return "t.number"
+	case "date":
+		return "instanceOf(Date, 'Date')"
+	case "boolean":
+		return "t.boolean"
+	case "uuid":
+		return "refinedString({ pattern: UUID_PATTERN })"
+	case "bytes":
+		return "instanceOf(Uint8Array, 'Uint8Array')"
+	case "enum":
+		var values []string
+		for _, e := range enum {
+			values = append(values, fmt.Sprintf("%s: null", quoteTS(e)))
+		}
+		return fmt.Sprintf("t.keyof({ %s })", strings.Join(values, ", "))
+	case "array":
+		return fmt.Sprintf("t.array(%s)", ioTsBase(items, "", nil))
+	default:
+		return "t.unknown"
+	}
+}
+
+type ArkType struct{}
+
+func (a *ArkType) Header(req domain.TypeRequest, dbType string) (string, error) {
+	return "import { type } from 'arktype';\n\n", nil
+}
+
+func (a *ArkType) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType string) (string, error) {
+	var sb strings.Builder
+
+	typeName := req.Prefix + common.ToPascalCase(tbN) + req.Suffix
+
+	fields, opt, err := readValidatorFields(rows, req, dbType)
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(fmt.Sprintf("export const %sSchema = type({\n", typeName))
+	sb.WriteString("  '+': 'reject',\n")
+	for _, f := range fields {
+		definition := arkTypeBase(f.Kind, f.Items, f.Enum)
+		if f.Max > 0 {
+			definition = fmt.Sprintf("%s <= %d", definition, f.Max)
+		}
+		nullable := f.Nullish || f.Nullable
+
+		var expr string
+		if f.Trim {
+			// Trimming is a morph, so the remaining constraints are applied to its output.
+			expr = fmt.Sprintf("type('string.trim').to(%s)", quoteTS(definition))
+			if nullable {
+				expr += ".or('null')"
+			}
+		} else {
+			if nullable {
+				definition += " | null"
+			}
+			expr = quoteTS(definition)
+		}
+
+		key := f.Name
+		if f.Nullish || f.Optional {
+			key = quoteTS(f.Name + "?")
+		}
+
+		writeFieldLine(&sb, key, expr, f)
+	}
+	sb.WriteString("});\n")
+
+	if opt.ExportAllTypes {
+		sb.WriteString(fmt.Sprintf("\nexport type %s = typeof %sSchema.infer;\n", typeName, typeName))
+	}
+
+	return sb.String(), nil
+}
+
+func arkTypeBase(kind, items string, enum []string) string {
+	switch kind {
+	case "string":
+		return "string"
+	case "number":
+		return "number"
+	case "date":
+		return "Date"
+	case "boolean":
+		return "boolean"
+	case "uuid":
+		return "string.uuid"
+	case "bytes":
+		return "Uint8Array"
+	case "enum":
+		var values []string
+		for _, e := range enum {
+			values = append(values, `"`+strings.ReplaceAll(e, `"`, `\"`)+`"`)
+		}
+		return strings.Join(values, " | ")
+	case "array":
+		return arkTypeBase(items, "", nil) + "[]"
+	default:
+		return "unknown"
+	}
+}
@@ -27,40 +27,16 @@ func (z Zod) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType
 	sb.WriteString(tableName)
 	sb.WriteString("Schema = z.object({\n")
 
-	for rows.Next() {
-		var ordinal int
-		var columnName string
-		var isNullable string
-		var characterMaximumLength sql.NullInt16
-		var dataType string
-		var columnKey string
-		var columnComment sql.NullString
-
-		if err := rows.Scan(
-			&ordinal,
-			&columnName,
-			&isNullable,
-			&characterMaximumLength,
-			&dataType,
-			&columnKey,
-			&columnComment,
-		); err != nil {
-			return "", err
-		}
+	columns, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", err
+	}
 
-		var zodType string
-		switch strings.ToLower(dbType) {
-		case "mysql":
-			zodType = mapMySQLToZod(dataType)
-		case "postgres":
-			zodType = mapPostgresToZod(dataType)
-		case "mssql":
-			zodType = mapMSSQLToZod(dataType)
-		default:
-			zodType = "any"
-		}
+	for _, col := range columns {
+		kind, items := columnKind(dbType, col)
+		zodType := zodExpr(kind, items, common.EnumValues(col))
 
-		fieldName := common.ToCamelCase(columnName)
+		fieldName := common.ToCamelCase(col.ColumnName)
 
 		sb.WriteString("  ")
 		sb.WriteString(fieldName)
@@ -74,16 +50,16 @@ func (z Zod) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType
 
 		// max length
 		if opt.MaxValue &&
-			characterMaximumLength.Valid &&
+			col.CharacterMaximumLength.Valid &&
 			zodType == "string()" {
-			sb.WriteString(fmt.Sprintf(".max(%d)", characterMaximumLength.Int16))
+			sb.WriteString(fmt.Sprintf(".max(%d)", col.CharacterMaximumLength.Int16))
 		}
 
 		// nullability handling (ORDER MATTERS)
 		if opt.Nullish {
 			sb.WriteString(".nullish()")
 		} else {
-			if opt.Nullable || isNullable == "YES" {
+			if opt.Nullable || col.IsNullable == "YES" {
 				sb.WriteString(".nullable()")
 			}
 			if opt.AllOptional {
@@ -94,8 +70,8 @@ func (z Zod) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType
 		sb.WriteString(",")
 
 		// comments
-		if opt.Comments && columnComment.Valid && strings.TrimSpace(columnComment.String) != "" {
-			sb.WriteString(fmt.Sprintf(" // %s", columnComment.String))
+		if opt.Comments && col.ColumnComment.Valid && strings.TrimSpace(col.ColumnComment.String) != "" {
+			sb.WriteString(fmt.Sprintf(" // %s", col.ColumnComment.String))
 		}
 
 		sb.WriteString("\n")
@@ -115,103 +91,30 @@ func (z Zod) Generate(rows *sql.Rows, req domain.TypeRequest, tbN string, dbType
 
 }
 
-func mapMySQLToZod(mysqlType string) string {
-	switch strings.ToLower(mysqlType) {
-
-	case "int", "bigint", "smallint", "tinyint", "decimal", "float", "double", "long":
-		return "number()"
-
-	case "varchar", "char", "text", "longtext":
+// zodExpr renders a column kind as a Zod type expression.
+func zodExpr(kind, items string, enum []string) string {
+	switch kind {
+	case "string":
 		return "string()"
-
-	case "datetime", "timestamp", "date":
-		return "date()"
-
-	case "boolean", "bit":
-		return "bool()"
-
-	case "enum":
-		return "enum([])"
-
-	default:
-		return "string()"
-	}
-}
-
-func mapPostgresToZod(pgType string) string {
-	switch strings.ToLower(pgType) {
-
-	case "smallint", "int2",
-		"integer", "int", "int4", "serial",
-		"bigint", "int8", "bigserial",
-		"decimal", "numeric", "money",
-		"real", "float4",
-		"double precision", "float8":
+	case "number":
 		return "number()"
-
-	case "varchar", "character varying",
-		"char", "character",
-		"text", "citext",
-		"inet", "cidr", "macaddr",
-		"xml":
-		return "string()"
-
-	case "boolean", "bool":
-		return "bool()"
-
-	case "date",
-		"timestamp", "timestamp without time zone",
-		"timestamptz", "timestamp with time zone",
-		"time", "time without time zone":
+	case "date":
 		return "date()"
-
+	case "boolean":
+		return "bool()"
 	case "uuid":
 		return "string().uuid()"
-
-	case "json", "jsonb":
-		return "any()"
-
-	case "bytea":
+	case "bytes":
 		return "instanceof(Uint8Array)"
-
-	case "integer[]", "_int4":
-		return "array(z.number())"
-	case "bigint[]", "_int8":
-		return "array(z.number())"
-	case "text[]", "_text":
-		return "array(z.string())"
-	case "uuid[]", "_uuid":
-		return "array(z.string().uuid())"
-
 	case "enum":
-		return "enum([])"
-
+		var values []string
+		for _, e := range enum {
+			values = append(values, quoteTS(e))
+		}
+		return fmt.Sprintf("enum([%s])", strings.Join(values, ", "))
+	case "array":
+		return "array(z." + zodExpr(items, "", nil) + ")"
 	default:
-		return "string()"
-	}
-}
-
-func mapMSSQLToZod(mssqlType string) string {
-	switch strings.ToLower(mssqlType) {
-	case "int", "bigint", "smallint", "tinyint",
-		"decimal", "numeric", "float", "real",
-		"money", "smallmoney":
-		return "number()"
-	case "varchar", "nvarchar", "char", "nchar", "text", "ntext":
-		return "string()"
-	case "datetime", "datetime2", "smalldatetime", "date", "time", "datetimeoffset":
-		return "date()"
-	case "bit":
-		return "bool()"
-	case "binary", "varbinary", "image", "rowversion", "timestamp":
-		return "instanceof(Uint8Array)"
-	case "uniqueidentifier":
-		return "string().uuid()"
-	case "xml":
-		return "string()"
-	case "sql_variant", "hierarchyid", "geometry", "geography":
 		return "any()"
-	default:
-		return "string()"
 	}
 }
@@ -32,14 +32,22 @@ func (s *TypeService) Generate(c *gin.Context, req domain.TypeRequest) (string,
 		}
 	}(db)
 
+	generator, err := gen.NewGenerator(req)
+	if err != nil {
+		return "", err
+	}
+
 	var result strings.Builder
-	for _, value := range req.TableNames {
-		cols, err := reader.ReadSchema(connInfo, db, value)
+	if bundle, ok := generator.(gen.BundleHeader); ok {
+		header, err := bundle.Header(req, connInfo.DbType)
 		if err != nil {
 			return "", err
 		}
+		result.WriteString(header)
+	}
 
-		generator, err := gen.NewGenerator(req)
+	for _, value := range req.TableNames {
+		cols, err := reader.ReadSchema(connInfo, db, value)
 		if err != nil {
 			return "", err
 		}
@@ -48,8 +56,21 @@ func (s *TypeService) Generate(c *gin.Context, req domain.TypeRequest) (string,
 		if err != nil {
 			return "", err
 		}
+		// Generators that render the whole bundle in their footer return nothing per table.
+		if output == "" {
+			continue
+		}
 		result.WriteString(output)
 		result.WriteString("\n")
 	}
+
+	if bundle, ok := generator.(gen.BundleFooter); ok {
+		footer, err := bundle.Footer(req, connInfo.DbType)
+		if err != nil {
+			return "", err
+		}
+		result.WriteString(footer)
+	}
+
 	return result.String(), nil
 }
@@ -0,0 +1,277 @@
+package csharp
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	csharptypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/csharp"
+)
+
+// Dapper writes a Dapper repository for one table, with the row class and an
+// interface to register it with dependency injection.
+type Dapper struct{}
+
+var csharpKeywords = []string{
+	"abstract", "as", "base", "bool", "break", "byte", "case", "catch", "char", "checked", "class",
+	"const", "continue", "decimal", "default", "delegate", "do", "double", "else", "enum", "event",
+	"explicit", "extern", "false", "finally", "fixed", "float", "for", "foreach", "goto", "if",
+	"implicit", "in", "int", "interface", "internal", "is", "lock", "long", "namespace", "new", "null",
+	"object", "operator", "out", "override", "params", "private", "protected", "public", "readonly",
+	"ref", "return", "sbyte", "sealed", "short", "sizeof", "stackalloc", "static", "string", "struct",
+	"switch", "this", "throw", "true", "try", "typeof", "uint", "ulong", "unchecked", "unsafe",
+	"ushort", "using", "virtual", "void", "volatile", "while",
+}
+
+func (d *Dapper) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	var opt domain.DapperOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Dapper Options", fmt.Errorf("invalid Dapper options: %w", err)
+	}
+
+	rowsData, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", fmt.Errorf("failed to scan rows: %w", err)
+	}
+
+	className := common.ToPascalCase(tbN)
+	interfaceName := "I" + className + "Repository"
+	repositoryName := className + "Repository"
+	primaryKeys := common.PrimaryKeys(rowsData)
+
+	var identity *domain.SqlData
+	var insertColumns, updateColumns []domain.SqlData
+	for i, col := range rowsData {
+		if col.IsIdentity == "YES" && identity == nil {
+			identity = &rowsData[i]
+			continue
+		}
+		if col.IsGenerated == "YES" {
+			continue
+		}
+		insertColumns = append(insertColumns, col)
+		if !common.IsPrimaryKey(col) {
+			updateColumns = append(updateColumns, col)
+		}
+	}
+
+	var keyParams []string
+	var keyArgs []string
+	for _, pk := range primaryKeys {
+		name := parameterName(pk.ColumnName)
+		keyParams = append(keyParams, fmt.Sprintf("%s %s", csharptypes.FieldType(dbType, pk), name))
+		keyArgs = append(keyArgs, fmt.Sprintf("%s = %s", common.ToPascalCase(pk.ColumnName), name))
+	}
+	keyObject := fmt.Sprintf("new { %s }", strings.Join(keyArgs, ", "))
+
+	table := common.QuoteIdentifier(dbType, tbN)
+	selectSQL := []string{"SELECT"}
+	for i, col := range rowsData {
+		column := common.QuoteIdentifier(dbType, col.ColumnName)
+		// Dapper maps columns to properties by name.
+		if property := common.ToPascalCase(col.ColumnName); property != col.ColumnName {
+			column += " AS " + common.QuoteIdentifier(dbType, property)
+		}
+		if i < len(rowsData)-1 {
+			column += ","
+		}
+		selectSQL = append(selectSQL, "    "+column)
+	}
+	selectSQL = append(selectSQL, "FROM "+table)
+
+	type method struct {
+		Signature string
+		Body      string
+	}
+	var methods []method
+	cancellation := "CancellationToken cancellationToken = default"
+
+	if opt.AllCrud || opt.Select {
+		var sb strings.Builder
+		writeSql(&sb, selectSQL)
+		sb.WriteString(fmt.Sprintf("        return _connection.QueryAsync<%s>(new CommandDefinition(sql, cancellationToken: cancellationToken));\n", className))
+		methods = append(methods, method{
+			Signature: fmt.Sprintf("Task<IEnumerable<%s>> GetAllAsync(%s)", className, cancellation),
+			Body:      sb.String(),
+		})
+
+		if len(primaryKeys) > 0 {
+			var sb strings.Builder
+			writeSql(&sb, append(slices.Clone(selectSQL), "WHERE "+whereClause(dbType, primaryKeys)))
+			sb.WriteString(fmt.Sprintf("        return _connection.QuerySingleOrDefaultAsync<%s?>(new CommandDefinition(sql, %s, cancellationToken: cancellationToken));\n",
+				className, keyObject))
+			methods = append(methods, method{
+				Signature: fmt.Sprintf("Task<%s?> GetByIdAsync(%s, %s)", className, strings.Join(keyParams, ", "), cancellation),
+				Body:      sb.String(),
+			})
+		}
+	}
+
+	if (opt.AllCrud || opt.Insert) && len(insertColumns) > 0 {
+		var names, values []string
+		for _, col := range insertColumns {
+			names = append(names, common.QuoteIdentifier(dbType, col.ColumnName))
+			values = append(values, parameter(col.ColumnName))
+		}
+
+		var sb strings.Builder
+		if identity == nil {
+			writeSql(&sb, []string{
+				fmt.Sprintf("INSERT INTO %s (%s)", table, strings.Join(names, ", ")),
+				fmt.Sprintf("VALUES (%s)", strings.Join(values, ", ")),
+			})
+			sb.WriteString("        return _connection.ExecuteAsync(new CommandDefinition(sql, entity, cancellationToken: cancellationToken));\n")
+			methods = append(methods, method{
+				Signature: fmt.Sprintf("Task<int> InsertAsync(%s entity, %s)", className, cancellation),
+				Body:      sb.String(),
+			})
+		} else {
+			idType := csharptypes.FieldType(dbType, *identity)
+			idProperty := common.ToPascalCase(identity.ColumnName)
+			writeSql(&sb, insertReturningIdentity(dbType, table, names, values, common.QuoteIdentifier(dbType, identity.ColumnName)))
+			sb.WriteString(fmt.Sprintf("        entity.%s = await _connection.ExecuteScalarAsync<%s>(new CommandDefinition(sql, entity, cancellationToken: cancellationToken));\n",
+				idProperty, idType))
+			sb.WriteString(fmt.Sprintf("        return entity.%s;\n", idProperty))
+			methods = append(methods, method{
+				Signature: fmt.Sprintf("async Task<%s> InsertAsync(%s entity, %s)", idType, className, cancellation),
+				Body:      sb.String(),
+			})
+		}
+	}
+
+	if (opt.AllCrud || opt.Update) && len(primaryKeys) > 0 && len(updateColumns) > 0 {
+		var assignments []string
+		for i, col := range updateColumns {
+			assignment := fmt.Sprintf("    %s = %s", common.QuoteIdentifier(dbType, col.ColumnName), parameter(col.ColumnName))
+			if i < len(updateColumns)-1 {
+				assignment += ","
+			}
+			assignments = append(assignments, assignment)
+		}
+
+		var sb strings.Builder
+		writeSql(&sb, slices.Concat(
+			[]string{fmt.Sprintf("UPDATE %s SET", table)},
+			assignments,
+			[]string{"WHERE " + whereClause(dbType, primaryKeys)},
+		))
+		sb.WriteString("        return _connection.ExecuteAsync(new CommandDefinition(sql, entity, cancellationToken: cancellationToken));\n")
+		methods = append(methods, method{
+			Signature: fmt.Sprintf("Task<int> UpdateAsync(%s entity, %s)", className, cancellation),
+			Body:      sb.String(),
+		})
+	}
+
+	if (opt.AllCrud || opt.Delete) && len(primaryKeys) > 0 {
+		var sb strings.Builder
+		writeSql(&sb, []string{
+			"DELETE FROM " + table,
+			"WHERE " + whereClause(dbType, primaryKeys),
+		})
+		sb.WriteString(fmt.Sprintf("        return _connection.ExecuteAsync(new CommandDefinition(sql, %s, cancellationToken: cancellationToken));\n", keyObject))
+		methods = append(methods, method{
+			Signature: fmt.Sprintf("Task<int> DeleteAsync(%s, %s)", strings.Join(keyParams, ", "), cancellation),
+			Body:      sb.String(),
+		})
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("using System;\n")
+	sb.WriteString("using System.Collections.Generic;\n")
+	sb.WriteString("using System.Data;\n")
+	sb.WriteString("using System.Threading;\n")
+	sb.WriteString("using System.Threading.Tasks;\n")
+	sb.WriteString("using Dapper;\n")
+
+	if opt.Namespace != "" {
+		sb.WriteString(fmt.Sprintf("\nnamespace %s;\n", opt.Namespace))
+	}
+
+	sb.WriteString(fmt.Sprintf("\npublic class %s\n{\n", className))
+	for _, col := range rowsData {
+		propertyType := csharptypes.FieldType(dbType, col)
+		initializer := ""
+		if strings.EqualFold(col.IsNullable, "YES") {
+			propertyType += "?"
+		} else if !csharptypes.IsValueType(propertyType) {
+			initializer = " = null!;"
+		}
+		sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }%s\n", propertyType, common.ToPascalCase(col.ColumnName), initializer))
+	}
+	sb.WriteString("}\n")
+
+	sb.WriteString(fmt.Sprintf("\npublic interface %s\n{\n", interfaceName))
+	for _, m := range methods {
+		sb.WriteString(fmt.Sprintf("    %s;\n", strings.TrimPrefix(m.Signature, "async ")))
+	}
+	sb.WriteString("}\n")
+
+	sb.WriteString(fmt.Sprintf("\npublic class %s : %s\n{\n", repositoryName, interfaceName))
+	sb.WriteString("    private readonly IDbConnection _connection;\n\n")
+	sb.WriteString(fmt.Sprintf("    public %s(IDbConnection connection)\n", repositoryName))
+	sb.WriteString("    {\n")
+	sb.WriteString("        _connection = connection;\n")
+	sb.WriteString("    }\n")
+	for _, m := range methods {
+		sb.WriteString(fmt.Sprintf("\n    public %s\n", m.Signature))
+		sb.WriteString("    {\n")
+		sb.WriteString(m.Body)
+		sb.WriteString("    }\n")
+	}
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+// writeSql declares the statement as a raw string literal.
+func writeSql(sb *strings.Builder, lines []string) {
+	sb.WriteString("        const string sql = \"\"\"\n")
+	for _, line := range lines {
+		sb.WriteString("            " + line + "\n")
+	}
+	sb.WriteString("            \"\"\";\n")
+}
+
+// insertReturningIdentity inserts a row and selects the key the database
+// generated for it.
+func insertReturningIdentity(dbType, table string, names, values []string, identity string) []string {
+	columns := fmt.Sprintf("INSERT INTO %s (%s)", table, strings.Join(names, ", "))
+	switch strings.ToLower(dbType) {
+	case "mssql":
+		return []string{columns, "OUTPUT INSERTED." + identity, fmt.Sprintf("VALUES (%s)", strings.Join(values, ", "))}
+	case "postgres":
+		return []string{columns, fmt.Sprintf("VALUES (%s)", strings.Join(values, ", ")), "RETURNING " + identity}
+	default:
+		return []string{columns, fmt.Sprintf("VALUES (%s);", strings.Join(values, ", ")), "SELECT LAST_INSERT_ID();"}
+	}
+}
+
+// parameter binds a column's property. SqlClient, Npgsql and MySqlConnector
+// all accept @-prefixed names, which Dapper fills from the parameter object.
+func parameter(columnName string) string {
+	return "@" + common.ToPascalCase(columnName)
+}
+
+func whereClause(dbType string, primaryKeys []domain.SqlData) string {
+	var conditions []string
+	for _, pk := range primaryKeys {
+		conditions = append(conditions, fmt.Sprintf("%s = %s", common.QuoteIdentifier(dbType, pk.ColumnName), parameter(pk.ColumnName)))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func parameterName(columnName string) string {
+	name := common.ToCamelCase(columnName)
+	if name == "entity" || name == "cancellationToken" || name == "sql" {
+		return name + "Value"
+	}
+	if slices.Contains(csharpKeywords, name) {
+		return "@" + name
+	}
+	return name
+}
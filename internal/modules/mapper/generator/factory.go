@@ -3,17 +3,27 @@ package generator
 import (
 	"fmt"
 	"github.com/khanalsaroj/typegen-server/internal/domain"
+	"github.com/khanalsaroj/typegen-server/internal/modules/mapper/generator/csharp"
+	"github.com/khanalsaroj/typegen-server/internal/modules/mapper/generator/golang"
 	"github.com/khanalsaroj/typegen-server/internal/modules/mapper/generator/java"
 	"strings"
 )
 
-func NewGenerator(req domain.MapperRequest) (Mapper, error) {
+func NewGenerator(req domain.MapperRequest, convention domain.AuditConvention) (Mapper, error) {
 	tgtTyp := strings.ToLower(string(req.TargetType))
 	switch tgtTyp {
 	case "mybatis-xml":
-		return &java.Xml{}, nil
+		return &java.Xml{Convention: convention}, nil
 	case "mybatis-annotation":
-		return &java.XmlAnnotation{}, nil
+		return &java.XmlAnnotation{Convention: convention}, nil
+	case "jdbc-template":
+		return &java.JdbcTemplate{Convention: convention}, nil
+	case "spring-data-jdbc":
+		return &java.SpringDataJdbc{Convention: convention}, nil
+	case "dapper":
+		return &csharp.Dapper{}, nil
+	case "go-repository":
+		return &golang.Repository{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported language: %s", req.TargetType)
 	}
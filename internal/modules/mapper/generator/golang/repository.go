@@ -0,0 +1,315 @@
+package golang
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	gotypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/golang"
+)
+
+// Repository writes a Go repository for one table using database/sql or pgx.
+type Repository struct{}
+
+type repositoryField struct {
+	Name string
+	Type string
+}
+
+func (r *Repository) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	var opt domain.GoRepositoryOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Repository Options", fmt.Errorf("invalid Go repository options: %w", err)
+	}
+
+	pgx := strings.EqualFold(opt.Driver, "pgx")
+	if pgx && !strings.EqualFold(dbType, "postgres") {
+		return "", fmt.Errorf("the pgx driver only supports postgres, not %s", dbType)
+	}
+
+	packageName := opt.Package
+	if packageName == "" {
+		packageName = "repository"
+	}
+
+	rowsData, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", fmt.Errorf("failed to scan rows: %w", err)
+	}
+
+	structName := common.ToPascalCase(tbN)
+	repositoryName := structName + "Repository"
+	receiver := fmt.Sprintf("func (r *%s)", repositoryName)
+
+	fields := map[string]repositoryField{}
+	var columnNames []string
+	usesTime := false
+	for _, col := range rowsData {
+		goType := gotypes.FieldType(dbType, col, domain.GoStructAdvancedOptions{PointerFields: true})
+		// database/sql cannot scan JSON into a map, so it is kept as raw bytes.
+		if !pgx && strings.Contains(goType, "map[string]interface{}") {
+			goType = "[]byte"
+		}
+		usesTime = usesTime || strings.Contains(goType, "time.Time")
+
+		fields[col.ColumnName] = repositoryField{
+			Name: common.ToPascalCase(col.ColumnName),
+			Type: goType,
+		}
+		columnNames = append(columnNames, common.QuoteIdentifier(dbType, col.ColumnName))
+	}
+
+	primaryKeys := common.PrimaryKeys(rowsData)
+
+	var identity *domain.SqlData
+	var insertColumns, updateColumns []domain.SqlData
+	for i, col := range rowsData {
+		if col.IsIdentity == "YES" && identity == nil {
+			identity = &rowsData[i]
+			continue
+		}
+		if col.IsGenerated == "YES" {
+			continue
+		}
+		insertColumns = append(insertColumns, col)
+		if !common.IsPrimaryKey(col) {
+			updateColumns = append(updateColumns, col)
+		}
+	}
+
+	dbHandle, queryRow, query, exec := "*sql.DB", "QueryRowContext", "QueryContext", "ExecContext"
+	if pgx {
+		dbHandle, queryRow, query, exec = "*pgxpool.Pool", "QueryRow", "Query", "Exec"
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import (\n")
+	sb.WriteString("    \"context\"\n")
+	if !pgx {
+		sb.WriteString("    \"database/sql\"\n")
+	}
+	if usesTime {
+		sb.WriteString("    \"time\"\n")
+	}
+	if pgx {
+		sb.WriteString("\n    \"github.com/jackc/pgx/v5/pgxpool\"\n")
+	}
+	sb.WriteString(")\n\n")
+
+	sb.WriteString(fmt.Sprintf("// %s is a row of the %s table.\n", structName, tbN))
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
+	for _, col := range rowsData {
+		f := fields[col.ColumnName]
+		sb.WriteString(fmt.Sprintf("    %s %s\n", f.Name, f.Type))
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// %s reads and writes %s rows.\n", repositoryName, tbN))
+	if usesTime && strings.EqualFold(dbType, "mysql") {
+		sb.WriteString("// The MySQL DSN needs parseTime=true to scan date columns into time.Time.\n")
+	}
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", repositoryName))
+	sb.WriteString(fmt.Sprintf("    db %s\n", dbHandle))
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("func New%s(db %s) *%s {\n", repositoryName, dbHandle, repositoryName))
+	sb.WriteString(fmt.Sprintf("    return &%s{db: db}\n", repositoryName))
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("func scan%s(row interface{ Scan(dest ...any) error }) (*%s, error) {\n", structName, structName))
+	sb.WriteString(fmt.Sprintf("    var m %s\n", structName))
+	var scanTargets []string
+	for _, col := range rowsData {
+		scanTargets = append(scanTargets, "&m."+fields[col.ColumnName].Name)
+	}
+	sb.WriteString(fmt.Sprintf("    if err := row.Scan(%s); err != nil {\n", strings.Join(scanTargets, ", ")))
+	sb.WriteString("        return nil, err\n")
+	sb.WriteString("    }\n")
+	sb.WriteString("    return &m, nil\n")
+	sb.WriteString("}\n")
+
+	table := common.QuoteIdentifier(dbType, tbN)
+	selectSQL := fmt.Sprintf("SELECT %s FROM %s", strings.Join(columnNames, ", "), table)
+
+	var keyParams, keyArgs []string
+	for _, pk := range primaryKeys {
+		param := paramName(pk.ColumnName)
+		keyParams = append(keyParams, fmt.Sprintf("%s %s", param, strings.TrimPrefix(fields[pk.ColumnName].Type, "*")))
+		keyArgs = append(keyArgs, param)
+	}
+
+	if opt.AllCrud || opt.Select {
+		if len(primaryKeys) > 0 {
+			where := whereClause(primaryKeys, dbType, 1)
+
+			sb.WriteString("\n")
+			sb.WriteString(fmt.Sprintf("%s Get(ctx context.Context, %s) (*%s, error) {\n", receiver, strings.Join(keyParams, ", "), structName))
+			sb.WriteString(fmt.Sprintf("    row := r.db.%s(ctx, %s, %s)\n", queryRow, sqlLiteral(selectSQL+" WHERE "+where), strings.Join(keyArgs, ", ")))
+			sb.WriteString(fmt.Sprintf("    return scan%s(row)\n", structName))
+			sb.WriteString("}\n")
+		}
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s List(ctx context.Context) ([]%s, error) {\n", receiver, structName))
+		sb.WriteString(fmt.Sprintf("    rows, err := r.db.%s(ctx, %s)\n", query, sqlLiteral(selectSQL)))
+		sb.WriteString("    if err != nil {\n")
+		sb.WriteString("        return nil, err\n")
+		sb.WriteString("    }\n")
+		sb.WriteString("    defer rows.Close()\n\n")
+		sb.WriteString(fmt.Sprintf("    var result []%s\n", structName))
+		sb.WriteString("    for rows.Next() {\n")
+		sb.WriteString(fmt.Sprintf("        m, err := scan%s(rows)\n", structName))
+		sb.WriteString("        if err != nil {\n")
+		sb.WriteString("            return nil, err\n")
+		sb.WriteString("        }\n")
+		sb.WriteString("        result = append(result, *m)\n")
+		sb.WriteString("    }\n")
+		sb.WriteString("    return result, rows.Err()\n")
+		sb.WriteString("}\n")
+	}
+
+	if opt.AllCrud || opt.Insert {
+		var names, placeholders, args []string
+		for i, col := range insertColumns {
+			names = append(names, common.QuoteIdentifier(dbType, col.ColumnName))
+			placeholders = append(placeholders, placeholder(dbType, i+1))
+			args = append(args, "m."+fields[col.ColumnName].Name)
+		}
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s Insert(ctx context.Context, m *%s) error {\n", receiver, structName))
+
+		switch {
+		case identity == nil:
+			insertSQL := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(names, ", "), strings.Join(placeholders, ", "))
+			sb.WriteString(fmt.Sprintf("    _, err := r.db.%s(ctx, %s, %s)\n", exec, sqlLiteral(insertSQL), strings.Join(args, ", ")))
+			sb.WriteString("    return err\n")
+		case strings.EqualFold(dbType, "mysql"):
+			// MySQL reports the generated key through LastInsertId.
+			insertSQL := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(names, ", "), strings.Join(placeholders, ", "))
+			id := fields[identity.ColumnName]
+			sb.WriteString(fmt.Sprintf("    res, err := r.db.%s(ctx, %s, %s)\n", exec, sqlLiteral(insertSQL), strings.Join(args, ", ")))
+			sb.WriteString("    if err != nil {\n")
+			sb.WriteString("        return err\n")
+			sb.WriteString("    }\n")
+			sb.WriteString("    id, err := res.LastInsertId()\n")
+			sb.WriteString("    if err != nil {\n")
+			sb.WriteString("        return err\n")
+			sb.WriteString("    }\n")
+			if id.Type == "int64" {
+				sb.WriteString(fmt.Sprintf("    m.%s = id\n", id.Name))
+			} else {
+				sb.WriteString(fmt.Sprintf("    m.%s = %s(id)\n", id.Name, id.Type))
+			}
+			sb.WriteString("    return nil\n")
+		default:
+			var insertSQL string
+			identityColumn := common.QuoteIdentifier(dbType, identity.ColumnName)
+			if strings.EqualFold(dbType, "mssql") {
+				insertSQL = fmt.Sprintf("INSERT INTO %s (%s) OUTPUT INSERTED.%s VALUES (%s)", table, strings.Join(names, ", "), identityColumn, strings.Join(placeholders, ", "))
+			} else {
+				insertSQL = fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) RETURNING %s", table, strings.Join(names, ", "), strings.Join(placeholders, ", "), identityColumn)
+			}
+			sb.WriteString(fmt.Sprintf("    return r.db.%s(ctx, %s, %s).Scan(&m.%s)\n", queryRow, sqlLiteral(insertSQL), strings.Join(args, ", "), fields[identity.ColumnName].Name))
+		}
+		sb.WriteString("}\n")
+	}
+
+	if (opt.AllCrud || opt.Update) && len(primaryKeys) > 0 && len(updateColumns) > 0 {
+		var assignments, args []string
+		for i, col := range updateColumns {
+			assignments = append(assignments, fmt.Sprintf("%s = %s", common.QuoteIdentifier(dbType, col.ColumnName), placeholder(dbType, i+1)))
+			args = append(args, "m."+fields[col.ColumnName].Name)
+		}
+		where := whereClause(primaryKeys, dbType, len(updateColumns)+1)
+		for _, pk := range primaryKeys {
+			args = append(args, "m."+fields[pk.ColumnName].Name)
+		}
+
+		updateSQL := fmt.Sprintf("UPDATE %s SET %s WHERE %s", table, strings.Join(assignments, ", "), where)
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s Update(ctx context.Context, m *%s) (int64, error) {\n", receiver, structName))
+		writeExecAffected(&sb, pgx, exec, updateSQL, args)
+		sb.WriteString("}\n")
+	}
+
+	if (opt.AllCrud || opt.Delete) && len(primaryKeys) > 0 {
+		where := whereClause(primaryKeys, dbType, 1)
+		deleteSQL := fmt.Sprintf("DELETE FROM %s WHERE %s", table, where)
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s Delete(ctx context.Context, %s) (int64, error) {\n", receiver, strings.Join(keyParams, ", ")))
+		writeExecAffected(&sb, pgx, exec, deleteSQL, keyArgs)
+		sb.WriteString("}\n")
+	}
+
+	// The builder writes plain indentation; gofmt settles the layout.
+	formatted, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated repository: %w", err)
+	}
+	return string(formatted), nil
+}
+
+func writeExecAffected(sb *strings.Builder, pgx bool, exec, query string, args []string) {
+	if pgx {
+		sb.WriteString(fmt.Sprintf("    tag, err := r.db.%s(ctx, %s, %s)\n", exec, sqlLiteral(query), strings.Join(args, ", ")))
+		sb.WriteString("    if err != nil {\n")
+		sb.WriteString("        return 0, err\n")
+		sb.WriteString("    }\n")
+		sb.WriteString("    return tag.RowsAffected(), nil\n")
+		return
+	}
+	sb.WriteString(fmt.Sprintf("    res, err := r.db.%s(ctx, %s, %s)\n", exec, sqlLiteral(query), strings.Join(args, ", ")))
+	sb.WriteString("    if err != nil {\n")
+	sb.WriteString("        return 0, err\n")
+	sb.WriteString("    }\n")
+	sb.WriteString("    return res.RowsAffected()\n")
+}
+
+// placeholder returns the n-th (1-based) bind parameter in the dialect's syntax.
+func placeholder(dbType string, n int) string {
+	switch strings.ToLower(dbType) {
+	case "postgres":
+		return fmt.Sprintf("$%d", n)
+	case "mssql":
+		return fmt.Sprintf("@p%d", n)
+	default:
+		return "?"
+	}
+}
+
+// whereClause matches every primary key column, numbering placeholders from start.
+func whereClause(primaryKeys []domain.SqlData, dbType string, start int) string {
+	var conditions []string
+	for i, pk := range primaryKeys {
+		conditions = append(conditions, fmt.Sprintf("%s = %s", common.QuoteIdentifier(dbType, pk.ColumnName), placeholder(dbType, start+i)))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// sqlLiteral writes a query as a raw string so quoted identifiers stay
+// readable, unless the query itself holds a backtick.
+func sqlLiteral(query string) string {
+	if strings.Contains(query, "`") {
+		return strconv.Quote(query)
+	}
+	return "`" + query + "`"
+}
+
+func paramName(columnName string) string {
+	name := common.ToCamelCase(columnName)
+	if token.IsKeyword(name) || name == "ctx" || name == "r" || name == "m" {
+		name += "Value"
+	}
+	return name
+}
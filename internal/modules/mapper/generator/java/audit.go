@@ -0,0 +1,210 @@
+package java
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	javatypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+)
+
+// audit is an AuditConvention resolved against one table. Columns the table
+// does not have are dropped, so a profile can be shared by every table.
+type audit struct {
+	insert     []auditAssignment
+	update     []auditAssignment
+	columns    []string
+	prefixes   []string
+	version    string
+	deleted    *auditAssignment
+	notDeleted string
+}
+
+// auditAssignment populates a column either from a bound property or from a
+// SQL expression.
+type auditAssignment struct {
+	Column   string
+	Property string
+	Sql      string
+}
+
+func resolveAudit(convention domain.AuditConvention, dbType string, rowsData []domain.SqlData) audit {
+	var a audit
+
+	find := func(name string) string {
+		if name == "" {
+			return ""
+		}
+		i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool {
+			return strings.EqualFold(col.ColumnName, name)
+		})
+		if i < 0 {
+			return ""
+		}
+		return rowsData[i].ColumnName
+	}
+	column := func(name string) string {
+		if name != "" {
+			a.columns = append(a.columns, strings.ToLower(name))
+		}
+		return find(name)
+	}
+	assign := func(target *[]auditAssignment, name, value string) {
+		if name = column(name); name != "" {
+			*target = append(*target, auditValue(name, value, dbType))
+		}
+	}
+
+	assign(&a.insert, convention.CreatedIpColumn, convention.CreatedIpValue)
+	assign(&a.insert, convention.CreatedByColumn, convention.CreatedByValue)
+	assign(&a.insert, convention.CreatedAtColumn, convention.CreatedAtValue)
+	assign(&a.update, convention.UpdatedIpColumn, convention.UpdatedIpValue)
+	assign(&a.update, convention.UpdatedByColumn, convention.UpdatedByValue)
+	assign(&a.update, convention.UpdatedAtColumn, convention.UpdatedAtValue)
+
+	if name := column(convention.DeletedColumn); name != "" {
+		deleted := auditValue(name, convention.DeletedValue, dbType)
+		a.deleted = &deleted
+		// Rows without a deletion marker, e.g. a NULL deleted_at, are live.
+		if convention.NotDeletedValue == "" {
+			a.notDeleted = fmt.Sprintf("%s IS NULL", name)
+		} else {
+			a.notDeleted = fmt.Sprintf("%s = %s", name, convention.NotDeletedValue)
+		}
+	}
+
+	// The version is selected and inserted like any other column.
+	a.version = find(convention.VersionColumn)
+
+	for _, prefix := range strings.Split(convention.IgnoredPrefixes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			a.prefixes = append(a.prefixes, prefix)
+		}
+	}
+
+	return a
+}
+
+func auditValue(column, value, dbType string) auditAssignment {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "param":
+		return auditAssignment{Column: column, Property: common.ToCamelCase(column)}
+	case "now":
+		return auditAssignment{Column: column, Sql: currentTimestamp(dbType)}
+	default:
+		return auditAssignment{Column: column, Sql: value}
+	}
+}
+
+func currentTimestamp(dbType string) string {
+	switch strings.ToLower(dbType) {
+	case "postgres":
+		return "CURRENT_TIMESTAMP"
+	case "mssql":
+		return "GETDATE()"
+	default:
+		return "CURRENT_TIMESTAMP(6)"
+	}
+}
+
+// value renders the assignment's SQL, binding properties of item when set.
+func (v auditAssignment) value(item string) string {
+	if v.Property == "" {
+		return v.Sql
+	}
+	return fmt.Sprintf("#{%s%s}", item, v.Property)
+}
+
+// skips reports whether a column is maintained by the convention rather than
+// mapped like the table's other columns.
+func (a audit) skips(col domain.SqlData) bool {
+	return slices.Contains(a.columns, strings.ToLower(col.ColumnName)) ||
+		hasAnyPrefixIgnoreCase(col.ColumnName, a.prefixes)
+}
+
+// params lists the audit columns bound from the DTO.
+func (a audit) params() []string {
+	var params []string
+	for _, v := range slices.Concat(a.insert, a.update) {
+		if v.Property != "" && !slices.Contains(params, v.Column) {
+			params = append(params, v.Column)
+		}
+	}
+	if a.deleted != nil && a.deleted.Property != "" && !slices.Contains(params, a.deleted.Column) {
+		params = append(params, a.deleted.Column)
+	}
+	return params
+}
+
+// insertColumns and insertValues list the column names and values of an
+// INSERT, audit columns last.
+func (a audit) insertColumns(columns []domain.SqlData) []string {
+	var names []string
+	for _, col := range columns {
+		names = append(names, col.ColumnName)
+	}
+	for _, v := range a.insert {
+		names = append(names, v.Column)
+	}
+	return names
+}
+
+func (a audit) insertValues(columns []domain.SqlData, item string) []string {
+	var values []string
+	for _, col := range columns {
+		values = append(values, fmt.Sprintf("#{%s%s}", item, common.ToCamelCase(col.ColumnName)))
+	}
+	for _, v := range a.insert {
+		values = append(values, v.value(item))
+	}
+	return values
+}
+
+// updateSet lists the assignments of an UPDATE. The version column is
+// incremented rather than bound, and the audit columns come last.
+func (a audit) updateSet(columns []domain.SqlData) []string {
+	var set []string
+	for _, col := range columns {
+		if a.version != "" && strings.EqualFold(col.ColumnName, a.version) {
+			continue
+		}
+		set = append(set, fmt.Sprintf("%s = #{%s}", col.ColumnName, common.ToCamelCase(col.ColumnName)))
+	}
+	if a.version != "" {
+		set = append(set, fmt.Sprintf("%s = %s + 1", a.version, a.version))
+	}
+	for _, v := range a.update {
+		set = append(set, fmt.Sprintf("%s = %s", v.Column, v.value("")))
+	}
+	return set
+}
+
+// softDeleteSet lists the assignments that mark a row deleted. Statements
+// that are not given a DTO leave out the audit properties they cannot bind.
+func (a audit) softDeleteSet(bound bool) []string {
+	set := []string{fmt.Sprintf("%s = %s", a.deleted.Column, a.deleted.value(""))}
+	for _, v := range a.update {
+		if v.Property == "" || bound {
+			set = append(set, fmt.Sprintf("%s = %s", v.Column, v.value("")))
+		}
+	}
+	return set
+}
+
+// deletedParam returns the Java type and property of a deletion marker that
+// is bound rather than computed. Statements given no DTO take it as an
+// argument so softDeleteSet(false) can still bind it.
+func (a audit) deletedParam(dbType string, rowsData []domain.SqlData) (string, string, bool) {
+	if a.deleted == nil || a.deleted.Property == "" {
+		return "", "", false
+	}
+	i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool {
+		return col.ColumnName == a.deleted.Column
+	})
+	if i < 0 {
+		return "", "", false
+	}
+	return javatypes.FieldType(dbType, rowsData[i]), a.deleted.Property, true
+}
@@ -1,45 +1,27 @@
 package java
 
 import (
-	"database/sql"
 	"fmt"
+	"github.com/khanalsaroj/typegen-server/internal/common"
 	"github.com/khanalsaroj/typegen-server/internal/domain"
 	"strings"
 )
 
-func scanRows(rows *sql.Rows) ([]domain.SqlData, error) {
-	var rowsData []domain.SqlData
-
-	for rows.Next() {
-		var dt domain.SqlData
-		err := rows.Scan(
-			&dt.Ordinal,
-			&dt.ColumnName,
-			&dt.IsNullable,
-			&dt.CharacterMaximumLength,
-			&dt.DataType,
-			&dt.ColumnKey,
-			&dt.ColumnComment,
-		)
-		if err != nil {
-			return nil, err
-		}
-		rowsData = append(rowsData, dt)
-	}
-
-	return rowsData, nil
-}
-
-func filterColumns(rowsData []domain.SqlData, skipPrefixes []string,
+func filterColumns(rowsData []domain.SqlData, a audit,
 	excludePrimaryKeys bool) []domain.SqlData {
 	var filtered []domain.SqlData
 
 	for _, row := range rowsData {
-		if excludePrimaryKeys && strings.Contains(row.ColumnKey, "PRI") {
+		if excludePrimaryKeys && common.IsPrimaryKey(row) {
 			continue
 		}
 
-		if hasAnyPrefixIgnoreCase(row.ColumnName, skipPrefixes) {
+		// Computed columns cannot be written, so they are left out with the keys.
+		if excludePrimaryKeys && row.IsGenerated == "YES" {
+			continue
+		}
+
+		if a.skips(row) {
 			continue
 		}
 
@@ -49,18 +31,6 @@ func filterColumns(rowsData []domain.SqlData, skipPrefixes []string,
 	return filtered
 }
 
-func getPrimaryKeys(rowsData []domain.SqlData) []domain.SqlData {
-	var primaryKeys []domain.SqlData
-
-	for _, row := range rowsData {
-		if strings.Contains(row.ColumnKey, "PRI") {
-			primaryKeys = append(primaryKeys, row)
-		}
-	}
-
-	return primaryKeys
-}
-
 func writeColumnList(sb *strings.Builder, columns []domain.SqlData) {
 	for i, row := range columns {
 		sb.WriteString(fmt.Sprintf("          %s", row.ColumnName))
@@ -82,8 +52,28 @@ func hasAnyPrefixIgnoreCase(columnName string, prefixes []string) bool {
 	return false
 }
 
+// writeList writes one item per line, separated by commas.
+func writeList(sb *strings.Builder, indent string, items []string) {
+	for i, item := range items {
+		sb.WriteString(indent + item)
+		if i < len(items)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+}
+
 func removeNumberOfLines(sb *strings.Builder, numberOfLines int) {
 	xml := sb.String()
 	sb.Reset()
 	sb.WriteString(xml[:len(xml)-numberOfLines])
 }
+
+// alwaysTrue is the condition that lets every key condition start with AND.
+// SQL Server has no boolean literals.
+func alwaysTrue(dbType string) string {
+	if strings.EqualFold(dbType, "mssql") {
+		return "1 = 1"
+	}
+	return "TRUE"
+}
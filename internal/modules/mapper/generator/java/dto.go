@@ -0,0 +1,124 @@
+package java
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	javatypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+)
+
+type javaField struct {
+	Name        string
+	Type        string
+	Annotations []string
+}
+
+// javaClass is a generated POJO with a getter and setter per field, or
+// Lombok's @Data in their place.
+type javaClass struct {
+	Package     string
+	Lombok      bool
+	Name        string
+	Imports     []string
+	Annotations []string
+	Fields      []javaField
+}
+
+// columnFields maps columns to the properties MyBatis binds them to.
+func columnFields(dbType string, columns []domain.SqlData) []javaField {
+	var fields []javaField
+	for _, col := range columns {
+		fields = append(fields, javaField{
+			Name: common.ToCamelCase(col.ColumnName),
+			Type: javatypes.FieldType(dbType, col),
+		})
+	}
+	return fields
+}
+
+// writeFileBanner separates the files of a bundle.
+func writeFileBanner(sb *strings.Builder, fileName string) {
+	sb.WriteString(fmt.Sprintf("==> %s <==\n", fileName))
+}
+
+func writePackage(sb *strings.Builder, packageName string) {
+	if packageName != "" {
+		sb.WriteString(fmt.Sprintf("package %s;\n\n", packageName))
+	}
+}
+
+// qualified prefixes a class name with the package when one is configured.
+func qualified(packageName, className string) string {
+	if packageName == "" {
+		return className
+	}
+	return packageName + "." + className
+}
+
+// javaImport returns the import a simple type name needs, if any.
+func javaImport(javaType string) string {
+	switch strings.TrimSuffix(javaType, "[]") {
+	case "BigDecimal":
+		return "java.math.BigDecimal"
+	case "LocalDate", "LocalDateTime", "LocalTime", "OffsetDateTime", "Year":
+		return "java.time." + strings.TrimSuffix(javaType, "[]")
+	case "UUID":
+		return "java.util.UUID"
+	default:
+		return ""
+	}
+}
+
+func writeJavaClass(sb *strings.Builder, class javaClass) {
+	writeFileBanner(sb, class.Name+".java")
+	writePackage(sb, class.Package)
+
+	imports := slices.Clone(class.Imports)
+	for _, field := range class.Fields {
+		if i := javaImport(field.Type); i != "" && !slices.Contains(imports, i) {
+			imports = append(imports, i)
+		}
+	}
+	if class.Lombok {
+		imports = append(imports, "lombok.Data")
+	}
+	slices.Sort(imports)
+	for _, i := range imports {
+		sb.WriteString(fmt.Sprintf("import %s;\n", i))
+	}
+	if len(imports) > 0 {
+		sb.WriteString("\n")
+	}
+
+	if class.Lombok {
+		sb.WriteString("@Data\n")
+	}
+	for _, annotation := range class.Annotations {
+		sb.WriteString(annotation + "\n")
+	}
+	sb.WriteString(fmt.Sprintf("public class %s {\n", class.Name))
+	for _, field := range class.Fields {
+		for _, annotation := range field.Annotations {
+			sb.WriteString(fmt.Sprintf("    %s\n", annotation))
+		}
+		sb.WriteString(fmt.Sprintf("    private %s %s;\n", field.Type, field.Name))
+	}
+
+	if !class.Lombok {
+		for _, field := range class.Fields {
+			property := upperFirst(field.Name)
+			sb.WriteString("\n")
+			sb.WriteString(fmt.Sprintf("    public %s get%s() {\n", field.Type, property))
+			sb.WriteString(fmt.Sprintf("        return %s;\n", field.Name))
+			sb.WriteString("    }\n\n")
+			sb.WriteString(fmt.Sprintf("    public void set%s(%s %s) {\n", property, field.Type, field.Name))
+			sb.WriteString(fmt.Sprintf("        this.%s = %s;\n", field.Name, field.Name))
+			sb.WriteString("    }\n")
+		}
+	}
+
+	sb.WriteString("}\n")
+}
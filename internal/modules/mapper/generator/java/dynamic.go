@@ -0,0 +1,235 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	javatypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+)
+
+// dynamicStatement is an optional statement written by both the XML and the
+// annotation mappers. Sql holds the body indented for the XML element.
+type dynamicStatement struct {
+	Kind      string
+	Id        string
+	Sql       string
+	Method    string
+	Script    bool
+	ResultMap bool
+}
+
+// dynamicStatements builds the statements enabled beyond plain CRUD.
+func dynamicStatements(opts domain.MyBatisOptions, dbType, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) []dynamicStatement {
+	var statements []dynamicStatement
+
+	selectColumns := filterColumns(rowsData, a, false)
+	writeColumns := filterColumns(rowsData, a, true)
+	primaryKeys := common.PrimaryKeys(rowsData)
+	response := interfaceName + "Response"
+	dto := interfaceName + "Dto"
+
+	if opts.SelectByPrimaryKey && len(primaryKeys) > 0 {
+		var sb strings.Builder
+		writeSelectFrom(&sb, selectColumns, tableName)
+		sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(dbType)))
+		if a.notDeleted != "" {
+			sb.WriteString(fmt.Sprintf("            AND %s\n", a.notDeleted))
+		}
+		var params []string
+		for _, pk := range primaryKeys {
+			property := common.ToCamelCase(pk.ColumnName)
+			sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n", pk.ColumnName, property))
+			params = append(params, fmt.Sprintf("@Param(\"%s\") %s %s", property, javatypes.FieldType(dbType, pk), property))
+		}
+		statements = append(statements, dynamicStatement{
+			Kind:      "select",
+			Id:        fmt.Sprintf("select%sByPrimaryKey", interfaceName),
+			Sql:       sb.String(),
+			Method:    fmt.Sprintf("%s select%sByPrimaryKey(%s)", response, interfaceName, strings.Join(params, ", ")),
+			ResultMap: true,
+		})
+	}
+
+	if opts.SelectByExample {
+		var sb strings.Builder
+		writeSelectFrom(&sb, selectColumns, tableName)
+		writeDynamicWhere(&sb, selectColumns, a)
+		statements = append(statements, dynamicStatement{
+			Kind:      "select",
+			Id:        fmt.Sprintf("select%sByExample", interfaceName),
+			Sql:       sb.String(),
+			Method:    fmt.Sprintf("List<%s> select%sByExample(@Param(\"example\") %s example)", response, interfaceName, response),
+			Script:    true,
+			ResultMap: true,
+		})
+	}
+
+	if opts.Count {
+		var sb strings.Builder
+		sb.WriteString("        SELECT COUNT(*)\n")
+		sb.WriteString(fmt.Sprintf("        FROM %s\n", tableName))
+		writeDynamicWhere(&sb, selectColumns, a)
+		statements = append(statements, dynamicStatement{
+			Kind:   "select",
+			Id:     fmt.Sprintf("count%s", interfaceName),
+			Sql:    sb.String(),
+			Method: fmt.Sprintf("long count%s(@Param(\"example\") %s example)", interfaceName, response),
+			Script: true,
+		})
+	}
+
+	if opts.Pagination {
+		var sb strings.Builder
+		writeSelectFrom(&sb, selectColumns, tableName)
+		writeDynamicWhere(&sb, selectColumns, a)
+		writePagination(&sb, dbType, primaryKeys)
+		statements = append(statements, dynamicStatement{
+			Kind: "select",
+			Id:   fmt.Sprintf("select%sPage", interfaceName),
+			Sql:  sb.String(),
+			Method: fmt.Sprintf("List<%s> select%sPage(@Param(\"example\") %s example, @Param(\"offset\") long offset, @Param(\"limit\") int limit)",
+				response, interfaceName, response),
+			Script:    true,
+			ResultMap: true,
+		})
+	}
+
+	if opts.UpdateSelective && len(primaryKeys) > 0 {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("        UPDATE %s\n", tableName))
+		sb.WriteString("        <set>\n")
+		for _, row := range writeColumns {
+			if strings.EqualFold(row.ColumnName, a.version) {
+				continue
+			}
+			property := common.ToCamelCase(row.ColumnName)
+			sb.WriteString(fmt.Sprintf("            <if test=\"%s != null\">%s = #{%s},</if>\n", property, row.ColumnName, property))
+		}
+		writeList(&sb, "            ", a.updateSet(nil))
+		sb.WriteString("        </set>\n")
+		sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(dbType)))
+		for _, pk := range primaryKeys {
+			sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n", pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
+		}
+		statements = append(statements, dynamicStatement{
+			Kind:   "update",
+			Id:     fmt.Sprintf("update%sSelective", interfaceName),
+			Sql:    sb.String(),
+			Method: fmt.Sprintf("int update%sSelective(%s dto)", interfaceName, dto),
+			Script: true,
+		})
+	}
+
+	if opts.BatchInsert {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("        INSERT INTO %s (\n", tableName))
+		writeList(&sb, "          ", a.insertColumns(writeColumns))
+		sb.WriteString("        ) VALUES\n")
+		sb.WriteString("        <foreach collection=\"list\" item=\"item\" separator=\",\">\n")
+		sb.WriteString(fmt.Sprintf("          (%s)\n", strings.Join(a.insertValues(writeColumns, "item."), ", ")))
+		sb.WriteString("        </foreach>\n")
+		statements = append(statements, dynamicStatement{
+			Kind:   "insert",
+			Id:     fmt.Sprintf("insert%sBatch", interfaceName),
+			Sql:    sb.String(),
+			Method: fmt.Sprintf("int insert%sBatch(@Param(\"list\") List<%s> list)", interfaceName, dto),
+			Script: true,
+		})
+	}
+
+	// Deleting by a list of ids needs a single column key.
+	if opts.DeleteByIds && len(primaryKeys) == 1 {
+		pk := primaryKeys[0]
+		var sb strings.Builder
+		if a.deleted != nil {
+			sb.WriteString(fmt.Sprintf("        UPDATE %s\n", tableName))
+			sb.WriteString("        SET\n")
+			writeList(&sb, "          ", a.softDeleteSet(false))
+		} else {
+			sb.WriteString("        DELETE\n")
+			sb.WriteString(fmt.Sprintf("        FROM %s\n", tableName))
+		}
+		sb.WriteString(fmt.Sprintf("        WHERE %s IN\n", pk.ColumnName))
+		sb.WriteString("        <foreach collection=\"ids\" item=\"id\" open=\"(\" separator=\",\" close=\")\">\n")
+		sb.WriteString("          #{id}\n")
+		sb.WriteString("        </foreach>\n")
+		params := []string{fmt.Sprintf("@Param(\"ids\") List<%s> ids", javatypes.FieldType(dbType, pk))}
+		if javaType, property, ok := a.deletedParam(dbType, rowsData); ok {
+			// The mapper interface imports no value types, so the type is qualified.
+			if i := javaImport(javaType); i != "" {
+				javaType = i
+			}
+			params = append(params, fmt.Sprintf("@Param(\"%s\") %s %s", property, javaType, property))
+		}
+		statements = append(statements, dynamicStatement{
+			Kind:   "delete",
+			Id:     fmt.Sprintf("delete%sByIds", interfaceName),
+			Sql:    sb.String(),
+			Method: fmt.Sprintf("int delete%sByIds(%s)", interfaceName, strings.Join(params, ", ")),
+			Script: true,
+		})
+	}
+
+	if opts.Upsert {
+		if statement, ok := upsertStatement(opts, dbType, interfaceName, tableName, rowsData, a); ok {
+			statements = append(statements, statement)
+		}
+	}
+
+	if opts.OptimisticLock {
+		if statement, ok := optimisticUpdateStatement(interfaceName, tableName, rowsData, a); ok {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements
+}
+
+func writeSelectFrom(sb *strings.Builder, columns []domain.SqlData, tableName string) {
+	sb.WriteString("        SELECT\n")
+	writeColumnList(sb, columns)
+	sb.WriteString(fmt.Sprintf("        FROM %s\n", tableName))
+}
+
+// writeDynamicWhere filters on every property of the example that is set,
+// always leaving out soft deleted rows.
+func writeDynamicWhere(sb *strings.Builder, columns []domain.SqlData, a audit) {
+	sb.WriteString("        <where>\n")
+	if a.notDeleted != "" {
+		sb.WriteString(fmt.Sprintf("            AND %s\n", a.notDeleted))
+	}
+	for _, row := range columns {
+		property := "example." + common.ToCamelCase(row.ColumnName)
+		sb.WriteString(fmt.Sprintf("            <if test=\"%s != null\">\n", property))
+		sb.WriteString(fmt.Sprintf("                AND %s = #{%s}\n", row.ColumnName, property))
+		sb.WriteString("            </if>\n")
+	}
+	sb.WriteString("        </where>\n")
+}
+
+// writePagination orders by the primary key so pages are stable, then
+// limits the rows with the syntax of the connection's database.
+func writePagination(sb *strings.Builder, dbType string, primaryKeys []domain.SqlData) {
+	var orderBy []string
+	for _, pk := range primaryKeys {
+		orderBy = append(orderBy, pk.ColumnName)
+	}
+
+	switch strings.ToLower(dbType) {
+	case "mssql":
+		// OFFSET ... FETCH requires an ORDER BY clause.
+		if len(orderBy) == 0 {
+			orderBy = []string{"(SELECT NULL)"}
+		}
+		sb.WriteString(fmt.Sprintf("        ORDER BY %s\n", strings.Join(orderBy, ", ")))
+		sb.WriteString("        OFFSET #{offset} ROWS FETCH NEXT #{limit} ROWS ONLY\n")
+	default:
+		if len(orderBy) > 0 {
+			sb.WriteString(fmt.Sprintf("        ORDER BY %s\n", strings.Join(orderBy, ", ")))
+		}
+		sb.WriteString("        LIMIT #{limit} OFFSET #{offset}\n")
+	}
+}
@@ -0,0 +1,328 @@
+package java
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	javatypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+)
+
+// JdbcTemplate writes a Spring repository built on NamedParameterJdbcTemplate
+// with a RowMapper for the table, followed by the DTOs it reads and writes.
+type JdbcTemplate struct {
+	Convention domain.AuditConvention
+}
+
+var mybatisParameter = regexp.MustCompile(`#\{([A-Za-z0-9_.]+)}`)
+
+// primitiveGetters are the ResultSet getters of boxed types, which read NULL
+// as zero and so are only used for NOT NULL columns.
+var primitiveGetters = map[string]string{
+	"Integer": "getInt",
+	"Long":    "getLong",
+	"Short":   "getShort",
+	"Byte":    "getByte",
+	"Double":  "getDouble",
+	"Float":   "getFloat",
+	"Boolean": "getBoolean",
+}
+
+func (d *JdbcTemplate) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	var opt domain.SpringJdbcOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid JdbcTemplate Options", fmt.Errorf("invalid JdbcTemplate Options: %w", err)
+	}
+
+	className := common.ToPascalCase(tbN)
+	tableName := tbN
+
+	rowsData, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", fmt.Errorf("failed to scan rows: %w", err)
+	}
+
+	a := resolveAudit(d.Convention, dbType, rowsData)
+
+	if opt.AllCrud {
+		opt.Select, opt.Insert, opt.Update, opt.Delete = true, true, true, true
+	}
+
+	selectColumns := filterColumns(rowsData, a, false)
+	writeColumns := filterColumns(rowsData, a, true)
+	primaryKeys := common.PrimaryKeys(rowsData)
+	response := className + "Response"
+	dto := className + "Dto"
+
+	var methods []string
+	imports := []string{
+		"org.springframework.jdbc.core.namedparam.NamedParameterJdbcTemplate",
+		"org.springframework.stereotype.Repository",
+	}
+	use := func(i ...string) {
+		for _, path := range i {
+			if !slices.Contains(imports, path) {
+				imports = append(imports, path)
+			}
+		}
+	}
+
+	// Types named by the row mapper and the key parameters.
+	for _, col := range slices.Concat(selectColumns, primaryKeys) {
+		if i := javaImport(javatypes.FieldType(dbType, col)); i != "" && (opt.Select || common.IsPrimaryKey(col)) {
+			use(i)
+		}
+	}
+
+	var keyParams, keyArgs, keyConditions []string
+	for _, pk := range primaryKeys {
+		property := common.ToCamelCase(pk.ColumnName)
+		keyParams = append(keyParams, fmt.Sprintf("%s %s", javatypes.FieldType(dbType, pk), property))
+		keyArgs = append(keyArgs, fmt.Sprintf(".addValue(%q, %s)", property, property))
+		keyConditions = append(keyConditions, fmt.Sprintf("%s = :%s", pk.ColumnName, property))
+	}
+	if a.notDeleted != "" {
+		keyConditions = append(keyConditions, a.notDeleted)
+	}
+
+	if opt.Select {
+		use("java.util.List", "org.springframework.jdbc.core.RowMapper")
+
+		var sb strings.Builder
+		sb.WriteString("SELECT\n")
+		writeList(&sb, "  ", columnNames(selectColumns))
+		sb.WriteString(fmt.Sprintf("FROM %s\n", tableName))
+		if a.notDeleted != "" {
+			sb.WriteString(fmt.Sprintf("WHERE %s\n", a.notDeleted))
+		}
+		methods = append(methods, fmt.Sprintf(
+			"    public List<%s> findAll() {\n        return jdbc.query(%s, ROW_MAPPER);\n    }\n",
+			response, textBlock(sb.String())))
+
+		if len(primaryKeys) > 0 {
+			use("java.util.Optional", "org.springframework.jdbc.core.namedparam.MapSqlParameterSource")
+
+			sb.Reset()
+			sb.WriteString("SELECT\n")
+			writeList(&sb, "  ", columnNames(selectColumns))
+			sb.WriteString(fmt.Sprintf("FROM %s\n", tableName))
+			sb.WriteString(fmt.Sprintf("WHERE %s\n", strings.Join(keyConditions, "\n  AND ")))
+			methods = append(methods, fmt.Sprintf(
+				"    public Optional<%s> findById(%s) {\n"+
+					"        MapSqlParameterSource params = new MapSqlParameterSource()%s;\n"+
+					"        return jdbc.query(%s, params, ROW_MAPPER).stream().findFirst();\n"+
+					"    }\n",
+				response, strings.Join(keyParams, ", "), strings.Join(keyArgs, ""), textBlock(sb.String())))
+		}
+	}
+
+	if opt.Insert {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("INSERT INTO %s (\n", tableName))
+		writeList(&sb, "  ", a.insertColumns(writeColumns))
+		sb.WriteString(") VALUES (\n")
+		writeList(&sb, "  ", a.insertValues(writeColumns, ""))
+		sb.WriteString(")\n")
+		insertSql := textBlock(namedParameters(sb.String()))
+
+		// Identity keys are read back into the DTO.
+		if i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool { return col.IsIdentity == "YES" }); i >= 0 {
+			use("org.springframework.jdbc.support.GeneratedKeyHolder", "org.springframework.jdbc.support.KeyHolder")
+			identity := rowsData[i]
+			property := common.ToCamelCase(identity.ColumnName)
+			methods = append(methods, fmt.Sprintf(
+				"    public int insert(%s dto) {\n"+
+					"        KeyHolder keyHolder = new GeneratedKeyHolder();\n"+
+					"        int rows = jdbc.update(%s, params(dto), keyHolder, new String[] {%q});\n"+
+					"        dto.set%s(keyHolder.getKeyAs(%s.class));\n"+
+					"        return rows;\n"+
+					"    }\n",
+				dto, insertSql, identity.ColumnName, upperFirst(property), javatypes.FieldType(dbType, identity)))
+		} else {
+			methods = append(methods, fmt.Sprintf(
+				"    public int insert(%s dto) {\n        return jdbc.update(%s, params(dto));\n    }\n",
+				dto, insertSql))
+		}
+	}
+
+	if opt.Update && len(primaryKeys) > 0 {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("UPDATE %s\n", tableName))
+		sb.WriteString("SET\n")
+		writeList(&sb, "  ", a.updateSet(writeColumns))
+		var conditions []string
+		for _, pk := range primaryKeys {
+			conditions = append(conditions, fmt.Sprintf("%s = #{%s}", pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
+		}
+		sb.WriteString(fmt.Sprintf("WHERE %s\n", strings.Join(conditions, "\n  AND ")))
+		methods = append(methods, fmt.Sprintf(
+			"    public int update(%s dto) {\n        return jdbc.update(%s, params(dto));\n    }\n",
+			dto, textBlock(namedParameters(sb.String()))))
+	}
+
+	if opt.Delete && len(primaryKeys) > 0 {
+		use("org.springframework.jdbc.core.namedparam.MapSqlParameterSource")
+
+		var sb strings.Builder
+		// Soft deletes mark the row instead of removing it.
+		if a.deleted != nil {
+			sb.WriteString(fmt.Sprintf("UPDATE %s\n", tableName))
+			sb.WriteString("SET\n")
+			writeList(&sb, "  ", a.softDeleteSet(false))
+		} else {
+			sb.WriteString(fmt.Sprintf("DELETE FROM %s\n", tableName))
+		}
+		conditions := keyConditions
+		if a.notDeleted != "" {
+			conditions = conditions[:len(conditions)-1]
+		}
+		sb.WriteString(fmt.Sprintf("WHERE %s\n", strings.Join(conditions, "\n  AND ")))
+		params, args := keyParams, keyArgs
+		if javaType, property, ok := a.deletedParam(dbType, rowsData); ok {
+			if i := javaImport(javaType); i != "" {
+				use(i)
+			}
+			params = append(slices.Clone(params), fmt.Sprintf("%s %s", javaType, property))
+			args = append(slices.Clone(args), fmt.Sprintf(".addValue(%q, %s)", property, property))
+		}
+		methods = append(methods, fmt.Sprintf(
+			"    public int deleteById(%s) {\n"+
+				"        MapSqlParameterSource params = new MapSqlParameterSource()%s;\n"+
+				"        return jdbc.update(%s, params);\n"+
+				"    }\n",
+			strings.Join(params, ", "), strings.Join(args, ""), textBlock(namedParameters(sb.String()))))
+	}
+
+	writesDto := opt.Insert || (opt.Update && len(primaryKeys) > 0)
+	if writesDto {
+		use("org.springframework.jdbc.core.namedparam.MapSqlParameterSource")
+	}
+
+	var sb strings.Builder
+
+	writeFileBanner(&sb, className+"Repository.java")
+	writePackage(&sb, opt.Package)
+	slices.Sort(imports)
+	for _, i := range imports {
+		sb.WriteString(fmt.Sprintf("import %s;\n", i))
+	}
+	sb.WriteString("\n")
+	sb.WriteString("@Repository\n")
+	sb.WriteString(fmt.Sprintf("public class %sRepository {\n", className))
+
+	if opt.Select {
+		sb.WriteString(fmt.Sprintf("    private static final RowMapper<%s> ROW_MAPPER = (rs, rowNum) -> {\n", response))
+		sb.WriteString(fmt.Sprintf("        %s row = new %s();\n", response, response))
+		for _, col := range selectColumns {
+			sb.WriteString(fmt.Sprintf("        row.set%s(%s);\n",
+				upperFirst(common.ToCamelCase(col.ColumnName)), resultSetGetter(col, javatypes.FieldType(dbType, col))))
+		}
+		sb.WriteString("        return row;\n")
+		sb.WriteString("    };\n\n")
+	}
+
+	sb.WriteString("    private final NamedParameterJdbcTemplate jdbc;\n\n")
+	sb.WriteString(fmt.Sprintf("    public %sRepository(NamedParameterJdbcTemplate jdbc) {\n", className))
+	sb.WriteString("        this.jdbc = jdbc;\n")
+	sb.WriteString("    }\n")
+
+	for _, method := range methods {
+		sb.WriteString("\n")
+		sb.WriteString(method)
+	}
+
+	fields := dtoFields(dbType, rowsData, a)
+	if writesDto {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("    private static MapSqlParameterSource params(%s dto) {\n", dto))
+		sb.WriteString("        return new MapSqlParameterSource()")
+		for _, field := range fields {
+			sb.WriteString(fmt.Sprintf("\n            .addValue(%q, dto.get%s())", field.Name, upperFirst(field.Name)))
+		}
+		sb.WriteString(";\n")
+		sb.WriteString("    }\n")
+	}
+
+	sb.WriteString("}\n")
+
+	if opt.Select {
+		sb.WriteString("\n")
+		writeJavaClass(&sb, javaClass{
+			Package: opt.Package,
+			Lombok:  opt.Lombok,
+			Name:    response,
+			Fields:  columnFields(dbType, selectColumns),
+		})
+	}
+	if writesDto {
+		sb.WriteString("\n")
+		writeJavaClass(&sb, javaClass{
+			Package: opt.Package,
+			Lombok:  opt.Lombok,
+			Name:    dto,
+			Fields:  fields,
+		})
+	}
+
+	return sb.String(), nil
+}
+
+// resultSetGetter reads a column with the ResultSet getter for its Java type.
+func resultSetGetter(col domain.SqlData, javaType string) string {
+	simple := javaType[strings.LastIndex(javaType, ".")+1:]
+	name := fmt.Sprintf("%q", col.ColumnName)
+
+	switch {
+	case simple == "String":
+		return fmt.Sprintf("rs.getString(%s)", name)
+	case simple == "BigDecimal":
+		return fmt.Sprintf("rs.getBigDecimal(%s)", name)
+	case simple == "byte[]":
+		return fmt.Sprintf("rs.getBytes(%s)", name)
+	case simple == "Object":
+		return fmt.Sprintf("rs.getObject(%s)", name)
+	case strings.HasSuffix(simple, "[]"):
+		return fmt.Sprintf("rs.getArray(%s) == null ? null : (%s) rs.getArray(%s).getArray()", name, javaType, name)
+	}
+
+	if getter, ok := primitiveGetters[simple]; ok && !strings.EqualFold(col.IsNullable, "YES") {
+		return fmt.Sprintf("rs.%s(%s)", getter, name)
+	}
+	return fmt.Sprintf("rs.getObject(%s, %s.class)", name, javaType)
+}
+
+// namedParameters rewrites MyBatis #{property} placeholders as :property.
+func namedParameters(sql string) string {
+	return mybatisParameter.ReplaceAllString(sql, ":$1")
+}
+
+// textBlock quotes SQL as a Java text block indented inside a method body.
+func textBlock(sql string) string {
+	var sb strings.Builder
+	sb.WriteString("\"\"\"\n")
+	for _, line := range strings.Split(strings.TrimSuffix(sql, "\n"), "\n") {
+		sb.WriteString("                " + line + "\n")
+	}
+	sb.WriteString("                \"\"\"")
+	return sb.String()
+}
+
+func columnNames(columns []domain.SqlData) []string {
+	var names []string
+	for _, col := range columns {
+		names = append(names, col.ColumnName)
+	}
+	return names
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
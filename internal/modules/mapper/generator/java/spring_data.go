@@ -0,0 +1,121 @@
+package java
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+	javatypes "github.com/khanalsaroj/typegen-server/internal/modules/gentype/generator/java"
+)
+
+// SpringDataJdbc writes a Spring Data JDBC aggregate for the table and the
+// CrudRepository interface for it.
+type SpringDataJdbc struct {
+	Convention domain.AuditConvention
+}
+
+func (d *SpringDataJdbc) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	var opt domain.SpringJdbcOptions
+	if err := json.Unmarshal(req.Options, &opt); err != nil {
+		return "Invalid Spring Data JDBC Options", fmt.Errorf("invalid Spring Data JDBC Options: %w", err)
+	}
+
+	className := common.ToPascalCase(tbN)
+
+	rowsData, err := common.ScanColumns(rows)
+	if err != nil {
+		return "", fmt.Errorf("failed to scan rows: %w", err)
+	}
+
+	primaryKeys := common.PrimaryKeys(rowsData)
+	if len(primaryKeys) != 1 {
+		return "", fmt.Errorf("spring data jdbc needs a single column primary key, but %s has %d", tbN, len(primaryKeys))
+	}
+
+	// Spring Data fills the audit columns it knows about itself.
+	auditAnnotations := map[string]string{}
+	for column, annotation := range map[string]string{
+		d.Convention.CreatedAtColumn: "@CreatedDate",
+		d.Convention.CreatedByColumn: "@CreatedBy",
+		d.Convention.UpdatedAtColumn: "@LastModifiedDate",
+		d.Convention.UpdatedByColumn: "@LastModifiedBy",
+	} {
+		if column != "" {
+			auditAnnotations[strings.ToLower(column)] = annotation
+		}
+	}
+
+	version := d.Convention.VersionColumn
+	if version == "" {
+		if i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool {
+			return common.IsVersionColumn(col.ColumnName)
+		}); i >= 0 {
+			version = rowsData[i].ColumnName
+		}
+	}
+
+	imports := []string{
+		"org.springframework.data.relational.core.mapping.Column",
+		"org.springframework.data.relational.core.mapping.Table",
+	}
+	use := func(path string) {
+		if !slices.Contains(imports, path) {
+			imports = append(imports, path)
+		}
+	}
+
+	var fields []javaField
+	for _, col := range rowsData {
+		var annotations []string
+		if common.IsPrimaryKey(col) {
+			use("org.springframework.data.annotation.Id")
+			annotations = append(annotations, "@Id")
+		}
+		if strings.EqualFold(col.ColumnName, version) {
+			use("org.springframework.data.annotation.Version")
+			annotations = append(annotations, "@Version")
+		}
+		if annotation, ok := auditAnnotations[strings.ToLower(col.ColumnName)]; ok {
+			use("org.springframework.data.annotation." + strings.TrimPrefix(annotation, "@"))
+			annotations = append(annotations, annotation)
+		}
+		// Computed columns are read but never written.
+		if col.IsGenerated == "YES" {
+			use("org.springframework.data.annotation.ReadOnlyProperty")
+			annotations = append(annotations, "@ReadOnlyProperty")
+		}
+		annotations = append(annotations, fmt.Sprintf("@Column(%q)", col.ColumnName))
+
+		fields = append(fields, javaField{
+			Name:        common.ToCamelCase(col.ColumnName),
+			Type:        javatypes.FieldType(dbType, col),
+			Annotations: annotations,
+		})
+	}
+
+	var sb strings.Builder
+
+	writeJavaClass(&sb, javaClass{
+		Package:     opt.Package,
+		Lombok:      opt.Lombok,
+		Name:        className,
+		Imports:     imports,
+		Annotations: []string{fmt.Sprintf("@Table(%q)", tbN)},
+		Fields:      fields,
+	})
+
+	sb.WriteString("\n")
+	writeFileBanner(&sb, className+"Repository.java")
+	writePackage(&sb, opt.Package)
+
+	sb.WriteString("import org.springframework.data.repository.CrudRepository;\n\n")
+	sb.WriteString(fmt.Sprintf("public interface %sRepository extends CrudRepository<%s, %s> {\n",
+		className, className, javatypes.FieldType(dbType, primaryKeys[0])))
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
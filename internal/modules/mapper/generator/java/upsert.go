@@ -0,0 +1,170 @@
+package java
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/khanalsaroj/typegen-server/internal/common"
+	"github.com/khanalsaroj/typegen-server/internal/domain"
+)
+
+// upsertKey returns the columns an upsert matches on: the configured unique
+// columns, or the primary key.
+func upsertKey(opts domain.MyBatisOptions, rowsData []domain.SqlData) []domain.SqlData {
+	if opts.UpsertKey == "" {
+		return common.PrimaryKeys(rowsData)
+	}
+
+	var keys []domain.SqlData
+	for _, name := range strings.Split(opts.UpsertKey, ",") {
+		i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool {
+			return strings.EqualFold(col.ColumnName, strings.TrimSpace(name))
+		})
+		if i < 0 {
+			return nil
+		}
+		keys = append(keys, rowsData[i])
+	}
+	return keys
+}
+
+// upsertStatement inserts a row or updates the one with the same key, using
+// the statement each database provides for it.
+func upsertStatement(opts domain.MyBatisOptions, dbType, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) (dynamicStatement, bool) {
+	keys := upsertKey(opts, rowsData)
+	if len(keys) == 0 {
+		return dynamicStatement{}, false
+	}
+	isKey := func(col domain.SqlData) bool {
+		return slices.ContainsFunc(keys, func(key domain.SqlData) bool { return key.ColumnName == col.ColumnName })
+	}
+
+	mssql := strings.EqualFold(dbType, "mssql")
+
+	var insertColumns, updateColumns []domain.SqlData
+	for _, col := range rowsData {
+		if a.skips(col) || col.IsGenerated == "YES" {
+			continue
+		}
+		// SQL Server rejects explicit identity values unless IDENTITY_INSERT is on.
+		if col.IsIdentity == "YES" && (mssql || !isKey(col)) {
+			continue
+		}
+		insertColumns = append(insertColumns, col)
+		if !isKey(col) && col.IsIdentity != "YES" {
+			updateColumns = append(updateColumns, col)
+		}
+	}
+
+	var keyNames []string
+	for _, key := range keys {
+		keyNames = append(keyNames, key.ColumnName)
+	}
+
+	var sb strings.Builder
+	switch strings.ToLower(dbType) {
+	case "mssql":
+		var source, match []string
+		for _, col := range insertColumns {
+			source = append(source, fmt.Sprintf("#{%s} AS %s", common.ToCamelCase(col.ColumnName), col.ColumnName))
+		}
+		for _, key := range keys {
+			if !slices.ContainsFunc(insertColumns, func(col domain.SqlData) bool { return col.ColumnName == key.ColumnName }) {
+				source = append(source, fmt.Sprintf("#{%s} AS %s", common.ToCamelCase(key.ColumnName), key.ColumnName))
+			}
+			match = append(match, fmt.Sprintf("target.%s = source.%s", key.ColumnName, key.ColumnName))
+		}
+
+		sb.WriteString(fmt.Sprintf("        MERGE INTO %s AS target\n", tableName))
+		sb.WriteString(fmt.Sprintf("        USING (SELECT %s) AS source\n", strings.Join(source, ", ")))
+		sb.WriteString(fmt.Sprintf("        ON %s\n", strings.Join(match, " AND ")))
+		if set := a.updateSet(updateColumns); len(set) > 0 {
+			sb.WriteString("        WHEN MATCHED THEN UPDATE SET\n")
+			writeList(&sb, "          ", set)
+		}
+		sb.WriteString("        WHEN NOT MATCHED THEN INSERT (\n")
+		writeList(&sb, "          ", a.insertColumns(insertColumns))
+		sb.WriteString("        ) VALUES (\n")
+		writeList(&sb, "          ", a.insertValues(insertColumns, ""))
+		// MERGE must be terminated by a semicolon.
+		sb.WriteString("        );\n")
+	default:
+		sb.WriteString(fmt.Sprintf("        INSERT INTO %s (\n", tableName))
+		writeList(&sb, "          ", a.insertColumns(insertColumns))
+		sb.WriteString("        ) VALUES (\n")
+		writeList(&sb, "          ", a.insertValues(insertColumns, ""))
+		sb.WriteString("        )\n")
+
+		set := a.updateSet(updateColumns)
+		if strings.EqualFold(dbType, "postgres") {
+			// Postgres needs the table name to tell the existing version apart from EXCLUDED.
+			for i, assignment := range set {
+				if a.version != "" && strings.HasPrefix(assignment, a.version+" = ") {
+					set[i] = fmt.Sprintf("%s = %s.%s + 1", a.version, tableName, a.version)
+				}
+			}
+			if len(set) == 0 {
+				sb.WriteString(fmt.Sprintf("        ON CONFLICT (%s) DO NOTHING\n", strings.Join(keyNames, ", ")))
+			} else {
+				sb.WriteString(fmt.Sprintf("        ON CONFLICT (%s) DO UPDATE SET\n", strings.Join(keyNames, ", ")))
+				writeList(&sb, "          ", set)
+			}
+		} else {
+			// MySQL matches on any unique key of the table.
+			if len(set) == 0 {
+				set = []string{fmt.Sprintf("%s = %s", keyNames[0], keyNames[0])}
+			}
+			sb.WriteString("        ON DUPLICATE KEY UPDATE\n")
+			writeList(&sb, "          ", set)
+		}
+	}
+
+	return dynamicStatement{
+		Kind:   "insert",
+		Id:     fmt.Sprintf("upsert%s", interfaceName),
+		Sql:    sb.String(),
+		Method: fmt.Sprintf("int upsert%s(%sDto dto)", interfaceName, interfaceName),
+	}, true
+}
+
+// optimisticUpdateStatement updates a row only when its version still
+// matches the one read, incrementing it. Zero affected rows means the row
+// was changed by someone else.
+func optimisticUpdateStatement(interfaceName, tableName string, rowsData []domain.SqlData,
+	a audit) (dynamicStatement, bool) {
+	primaryKeys := common.PrimaryKeys(rowsData)
+
+	version := a.version
+	if version == "" {
+		if i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool {
+			return common.IsVersionColumn(col.ColumnName)
+		}); i >= 0 {
+			version = rowsData[i].ColumnName
+		}
+	}
+	if version == "" || len(primaryKeys) == 0 {
+		return dynamicStatement{}, false
+	}
+	a.version = version
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("        UPDATE %s\n", tableName))
+	sb.WriteString("        SET\n")
+	writeList(&sb, "          ", a.updateSet(filterColumns(rowsData, a, true)))
+
+	var conditions []string
+	for _, pk := range primaryKeys {
+		conditions = append(conditions, fmt.Sprintf("%s = #{%s}", pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
+	}
+	conditions = append(conditions, fmt.Sprintf("%s = #{%s}", version, common.ToCamelCase(version)))
+	sb.WriteString(fmt.Sprintf("        WHERE %s\n", strings.Join(conditions, "\n          AND ")))
+
+	return dynamicStatement{
+		Kind:   "update",
+		Id:     fmt.Sprintf("update%sWithVersion", interfaceName),
+		Sql:    sb.String(),
+		Method: fmt.Sprintf("int update%sWithVersion(%sDto dto)", interfaceName, interfaceName),
+	}, true
+}
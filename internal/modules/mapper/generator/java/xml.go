@@ -4,132 +4,189 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/khanalsaroj/typegen-server/internal/common"
 	"github.com/khanalsaroj/typegen-server/internal/domain"
 )
 
-type Xml struct{}
+// Xml writes a MyBatis XML mapper together with the @Mapper interface it is
+// bound to and the DTOs its statements reference, one file after another.
+type Xml struct {
+	Convention domain.AuditConvention
+	dbType     string
+}
 
-func (d *Xml) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string) (string, error) {
-	const (
-		insertPrefix = "insert_"
-		updatePrefix = "update_"
-		deletePrefix = "delete_"
-	)
+func (d *Xml) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	d.dbType = dbType
 
 	var opt domain.MyBatisOptions
 	if err := json.Unmarshal(req.Options, &opt); err != nil {
 		return "Invalid MyBatis Options", fmt.Errorf("invalid MyBatis Options: %w", err)
 	}
 
-	skipPrefixes := []string{insertPrefix, updatePrefix, deletePrefix}
 	interfaceName := common.ToPascalCase(tbN)
 	tableName := tbN
 
-	rowsData, err := scanRows(rows)
+	rowsData, err := common.ScanColumns(rows)
 	if err != nil {
 		return "", fmt.Errorf("failed to scan rows: %w", err)
 	}
 
+	a := resolveAudit(d.Convention, dbType, rowsData)
+
+	if opt.AllCrud {
+		opt.Select, opt.Insert, opt.Update, opt.Delete = true, true, true, true
+	}
+
+	statements := dynamicStatements(opt, dbType, interfaceName, tableName, rowsData, a)
+	needsResponse, needsDto := opt.Select, opt.Insert || opt.Update || opt.Delete
+	for _, statement := range statements {
+		needsResponse = needsResponse || statement.Kind == "select"
+		needsDto = needsDto || statement.Kind == "insert" || statement.Kind == "update"
+	}
+
 	var sb strings.Builder
+
+	writeFileBanner(&sb, interfaceName+"Repository.xml")
+	sb.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\" ?>\n")
+	sb.WriteString("<!DOCTYPE mapper PUBLIC \"-//mybatis.org//DTD Mapper 3.0//EN\" \"https://mybatis.org/dtd/mybatis-3-mapper.dtd\">\n")
 	sb.WriteString("<mapper namespace=\"")
-	sb.WriteString(interfaceName)
-	sb.WriteString("Repository\">\n")
+	sb.WriteString(qualified(opt.Package, interfaceName+"Repository"))
+	sb.WriteString("\">\n")
 
-	generateMyBatis(opt, d, &sb, interfaceName, tableName, rowsData, skipPrefixes)
-	sb.WriteString("</mapper>")
+	if needsResponse {
+		d.writeResultMap(&sb, opt, interfaceName, rowsData, a)
+	}
+	generateMyBatis(opt, d, &sb, interfaceName, tableName, rowsData, a)
+	for _, statement := range statements {
+		d.writeDynamicStatement(&sb, interfaceName, statement)
+	}
+	sb.WriteString("</mapper>\n")
+
+	sb.WriteString("\n")
+	d.writeInterface(&sb, opt, interfaceName, statements)
+
+	if needsResponse {
+		sb.WriteString("\n")
+		writeJavaClass(&sb, javaClass{
+			Package: opt.Package,
+			Lombok:  opt.Lombok,
+			Name:    interfaceName + "Response",
+			Fields:  columnFields(dbType, filterColumns(rowsData, a, false)),
+		})
+	}
+	if needsDto {
+		sb.WriteString("\n")
+		writeJavaClass(&sb, javaClass{
+			Package: opt.Package,
+			Lombok:  opt.Lombok,
+			Name:    interfaceName + "Dto",
+			Fields:  dtoFields(dbType, rowsData, a),
+		})
+	}
 
 	return sb.String(), nil
 }
 
-func generateMyBatis(opts domain.MyBatisOptions, d *Xml, sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
-	if opts.AllCrud {
-		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData)
-		return
+// dtoFields lists the columns written by the statements followed by the
+// audit columns they bind.
+func dtoFields(dbType string, rowsData []domain.SqlData, a audit) []javaField {
+	fields := columnFields(dbType, filterColumns(rowsData, a, false))
+
+	for _, param := range a.params() {
+		i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool { return col.ColumnName == param })
+		fields = append(fields, columnFields(dbType, rowsData[i:i+1])...)
 	}
+
+	return fields
+}
+
+func generateMyBatis(opts domain.MyBatisOptions, d *Xml, sb *strings.Builder, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) {
 	if opts.Select {
-		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeSelectStatement(sb, opts, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Insert {
-		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeInsertStatement(sb, opts, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Update {
-		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeUpdateStatement(sb, opts, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Delete {
-		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData)
+		d.writeDeleteStatement(sb, opts, interfaceName, tableName, rowsData, a)
+	}
+}
+
+func (d *Xml) writeResultMap(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName string,
+	rowsData []domain.SqlData, a audit) {
+	sb.WriteString(fmt.Sprintf(`    <resultMap id="%sResultMap" type="%s">`,
+		interfaceName, qualified(opts.Package, interfaceName+"Response")))
+	sb.WriteString("\n")
+
+	for _, row := range filterColumns(rowsData, a, false) {
+		element := "result"
+		if common.IsPrimaryKey(row) {
+			element = "id"
+		}
+		sb.WriteString(fmt.Sprintf("        <%s column=\"%s\" property=\"%s\"/>\n",
+			element, row.ColumnName, common.ToCamelCase(row.ColumnName)))
 	}
+
+	sb.WriteString("    </resultMap>\n\n")
 }
 
-func (d *Xml) writeSelectStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
-	sb.WriteString(fmt.Sprintf(`    <select id="select%s" parameterType="%sResponse">`,
+func (d *Xml) writeSelectStatement(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) {
+	sb.WriteString(fmt.Sprintf(`    <select id="select%s" resultMap="%sResultMap">`,
 		interfaceName, interfaceName))
 	sb.WriteString("\n        SELECT\n")
 
-	columnNames := filterColumns(rowsData, skipPrefixes, false)
+	columnNames := filterColumns(rowsData, a, false)
 	writeColumnList(sb, columnNames)
 
 	removeNumberOfLines(sb, 1)
 
 	sb.WriteString(fmt.Sprintf("\n        FROM %s", tableName))
+	if a.notDeleted != "" {
+		sb.WriteString(fmt.Sprintf("\n        WHERE %s", a.notDeleted))
+	}
 	sb.WriteString("\n    </select>\n\n")
 }
 
-func (d *Xml) writeInsertStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
-	sb.WriteString(fmt.Sprintf(`    <insert id="insert%s" parameterType="%sDto">`,
-		interfaceName, interfaceName))
+func (d *Xml) writeInsertStatement(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) {
+	sb.WriteString(fmt.Sprintf(`    <insert id="insert%s" parameterType="%s"`,
+		interfaceName, qualified(opts.Package, interfaceName+"Dto")))
+	// Identity keys are read back into the DTO.
+	if i := slices.IndexFunc(rowsData, func(col domain.SqlData) bool { return col.IsIdentity == "YES" }); i >= 0 {
+		sb.WriteString(fmt.Sprintf(` useGeneratedKeys="true" keyProperty="%s" keyColumn="%s"`,
+			common.ToCamelCase(rowsData[i].ColumnName), rowsData[i].ColumnName))
+	}
+	sb.WriteString(">")
 	sb.WriteString(fmt.Sprintf("\n        INSERT INTO %s (", tableName))
 	sb.WriteString("\n")
 
-	insertColumns := filterColumns(rowsData, skipPrefixes, true)
-	writeColumnList(sb, insertColumns)
-
-	sb.WriteString("          insert_ip,\n")
-	sb.WriteString("          insert_user_id,\n")
-	sb.WriteString("          insert_dtm)\n")
-	sb.WriteString("        VALUES (\n")
-
-	for _, row := range insertColumns {
-		sb.WriteString(fmt.Sprintf("          #{%s},\n",
-			common.ToCamelCase(row.ColumnName)))
-	}
-
-	sb.WriteString("          #{insertIp},\n")
-	sb.WriteString("          #{insertUserId},\n")
-	sb.WriteString("          CURRENT_TIMESTAMP(6));\n")
+	insertColumns := filterColumns(rowsData, a, true)
+	writeList(sb, "          ", a.insertColumns(insertColumns))
+	sb.WriteString("        ) VALUES (\n")
+	writeList(sb, "          ", a.insertValues(insertColumns, ""))
+	sb.WriteString("        )\n")
 	sb.WriteString("    </insert>\n\n")
 }
 
-func (d *Xml) writeUpdateStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
-	sb.WriteString(fmt.Sprintf(`    <update id="update%s">`, interfaceName))
+func (d *Xml) writeUpdateStatement(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) {
+	sb.WriteString(fmt.Sprintf(`    <update id="update%s" parameterType="%s">`,
+		interfaceName, qualified(opts.Package, interfaceName+"Dto")))
 	sb.WriteString(fmt.Sprintf("\n        UPDATE %s", tableName))
 	sb.WriteString("\n        SET\n")
 
-	updateColumns := filterColumns(rowsData, skipPrefixes, true)
-	for i, row := range updateColumns {
-		sb.WriteString(fmt.Sprintf("          %s= #{%s}",
-			row.ColumnName, common.ToCamelCase(row.ColumnName)))
-		if i < len(updateColumns)-1 {
-			sb.WriteString(",")
-		}
-		sb.WriteString("\n")
-	}
-
-	sb.WriteString("          update_ip = #{updateIp},\n")
-	sb.WriteString("          update_user_id = #{updateUserId},\n")
-	sb.WriteString("          update_dtm = CURRENT_TIMESTAMP(6)\n")
-	sb.WriteString("        WHERE TRUE\n")
+	writeList(sb, "          ", a.updateSet(filterColumns(rowsData, a, true)))
+	sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(d.dbType)))
 
-	primaryKeys := getPrimaryKeys(rowsData)
+	primaryKeys := common.PrimaryKeys(rowsData)
 	for _, pk := range primaryKeys {
 		sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n",
 			pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
@@ -138,14 +195,23 @@ func (d *Xml) writeUpdateStatement(sb *strings.Builder, interfaceName, tableName
 	sb.WriteString("    </update>\n\n")
 }
 
-func (d *Xml) writeDeleteStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData) {
-	sb.WriteString(fmt.Sprintf(`    <delete id="delete%s">`, interfaceName))
-	sb.WriteString("\n        DELETE")
-	sb.WriteString(fmt.Sprintf("\n        FROM %s", tableName))
-	sb.WriteString("\n        WHERE TRUE\n")
+func (d *Xml) writeDeleteStatement(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName, tableName string,
+	rowsData []domain.SqlData, a audit) {
+	sb.WriteString(fmt.Sprintf(`    <delete id="delete%s" parameterType="%s">`,
+		interfaceName, qualified(opts.Package, interfaceName+"Dto")))
+	// Soft deletes mark the row instead of removing it.
+	if a.deleted != nil {
+		sb.WriteString(fmt.Sprintf("\n        UPDATE %s", tableName))
+		sb.WriteString("\n        SET\n")
+		writeList(sb, "          ", a.softDeleteSet(true))
+		sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(d.dbType)))
+	} else {
+		sb.WriteString("\n        DELETE")
+		sb.WriteString(fmt.Sprintf("\n        FROM %s", tableName))
+		sb.WriteString(fmt.Sprintf("\n        WHERE %s\n", alwaysTrue(d.dbType)))
+	}
 
-	primaryKeys := getPrimaryKeys(rowsData)
+	primaryKeys := common.PrimaryKeys(rowsData)
 	for _, pk := range primaryKeys {
 		sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n",
 			pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
@@ -153,3 +219,64 @@ func (d *Xml) writeDeleteStatement(sb *strings.Builder, interfaceName, tableName
 
 	sb.WriteString("    </delete>\n")
 }
+
+func (d *Xml) writeDynamicStatement(sb *strings.Builder, interfaceName string, statement dynamicStatement) {
+	// Statements are separated by a blank line, which the fixed ones other
+	// than delete already end with.
+	if xml := sb.String(); !strings.HasSuffix(xml, "\n\n") && !strings.HasSuffix(xml, "\">\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("    <%s id=\"%s\"", statement.Kind, statement.Id))
+	if statement.ResultMap {
+		sb.WriteString(fmt.Sprintf(" resultMap=\"%sResultMap\"", interfaceName))
+	} else if statement.Kind == "select" {
+		sb.WriteString(" resultType=\"long\"")
+	}
+	sb.WriteString(">\n")
+	sb.WriteString(statement.Sql)
+	sb.WriteString(fmt.Sprintf("    </%s>\n", statement.Kind))
+}
+
+// writeInterface writes the @Mapper interface with a method per statement id.
+func (d *Xml) writeInterface(sb *strings.Builder, opts domain.MyBatisOptions, interfaceName string,
+	statements []dynamicStatement) {
+	writeFileBanner(sb, interfaceName+"Repository.java")
+	writePackage(sb, opts.Package)
+
+	usesList, usesParam := opts.Select, false
+	for _, statement := range statements {
+		usesList = usesList || strings.Contains(statement.Method, "List<")
+		usesParam = usesParam || strings.Contains(statement.Method, "@Param")
+	}
+
+	if usesList {
+		sb.WriteString("import java.util.List;\n")
+	}
+	sb.WriteString("import org.apache.ibatis.annotations.Mapper;\n")
+	if usesParam {
+		sb.WriteString("import org.apache.ibatis.annotations.Param;\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString("@Mapper\n")
+	sb.WriteString(fmt.Sprintf("public interface %sRepository {\n", interfaceName))
+
+	var methods []string
+	if opts.Select {
+		methods = append(methods, fmt.Sprintf("    List<%sResponse> select%s();\n", interfaceName, interfaceName))
+	}
+	if opts.Insert {
+		methods = append(methods, fmt.Sprintf("    int insert%s(%sDto dto);\n", interfaceName, interfaceName))
+	}
+	if opts.Update {
+		methods = append(methods, fmt.Sprintf("    int update%s(%sDto dto);\n", interfaceName, interfaceName))
+	}
+	if opts.Delete {
+		methods = append(methods, fmt.Sprintf("    int delete%s(%sDto dto);\n", interfaceName, interfaceName))
+	}
+	for _, statement := range statements {
+		methods = append(methods, fmt.Sprintf("    %s;\n", statement.Method))
+	}
+	sb.WriteString(strings.Join(methods, "\n"))
+
+	sb.WriteString("}\n")
+}
@@ -6,78 +6,96 @@ import (
 	"fmt"
 	"github.com/khanalsaroj/typegen-server/internal/common"
 	"github.com/khanalsaroj/typegen-server/internal/domain"
+	"slices"
 	"strings"
 )
 
-type XmlAnnotation struct{}
+type XmlAnnotation struct {
+	Convention domain.AuditConvention
+	dbType     string
+}
 
-func (d *XmlAnnotation) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string) (string, error) {
-	const (
-		insertPrefix = "insert_"
-		updatePrefix = "update_"
-		deletePrefix = "delete_"
-	)
+func (d *XmlAnnotation) Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error) {
+	d.dbType = dbType
 
 	var opt domain.MyBatisOptions
 	if err := json.Unmarshal(req.Options, &opt); err != nil {
 		return "Invalid Annotation Options", fmt.Errorf("invalid Annotation Options: %w", err)
 	}
 
-	skipPrefixes := []string{insertPrefix, updatePrefix, deletePrefix}
 	interfaceName := common.ToPascalCase(tbN)
 	tableName := tbN
 
-	rowsData, err := scanRows(rows)
+	rowsData, err := common.ScanColumns(rows)
 	if err != nil {
 		return "", fmt.Errorf("failed to scan rows: %w", err)
 	}
 
+	a := resolveAudit(d.Convention, dbType, rowsData)
+	statements := dynamicStatements(opt, dbType, interfaceName, tableName, rowsData, a)
+
 	var sb strings.Builder
+	if slices.ContainsFunc(statements, func(statement dynamicStatement) bool {
+		return strings.Contains(statement.Method, "List<")
+	}) {
+		sb.WriteString("import java.util.List;\n")
+	}
 	sb.WriteString("import org.apache.ibatis.annotations.*;\n")
 	sb.WriteString("\n")
 	sb.WriteString("@Mapper\n")
 	sb.WriteString("public interface ")
 	sb.WriteString(interfaceName)
 	sb.WriteString("Repository {\n")
-	generateMyBatisAnnotation(opt, d, &sb, interfaceName, tableName, rowsData, skipPrefixes)
+	generateMyBatisAnnotation(opt, d, &sb, interfaceName, tableName, rowsData, a)
+	declared := false
+	for _, statement := range statements {
+		if statement.ResultMap {
+			d.writeResults(&sb, interfaceName, rowsData, a, declared)
+			declared = true
+		}
+		d.writeDynamicStatement(&sb, statement)
+	}
 	sb.WriteString("}")
 
 	return sb.String(), nil
 }
 
 func generateMyBatisAnnotation(opts domain.MyBatisOptions, d *XmlAnnotation, sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
+	rowsData []domain.SqlData, a audit) {
 	if opts.AllCrud {
-		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
-		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData)
+		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, a)
+		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, a)
+		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, a)
+		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData, a)
 		return
 	}
 	if opts.Select {
-		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeSelectStatement(sb, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Insert {
-		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeInsertStatement(sb, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Update {
-		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, skipPrefixes)
+		d.writeUpdateStatement(sb, interfaceName, tableName, rowsData, a)
 	}
 	if opts.Delete {
-		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData)
+		d.writeDeleteStatement(sb, interfaceName, tableName, rowsData, a)
 	}
 }
 
 func (d *XmlAnnotation) writeSelectStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
+	rowsData []domain.SqlData, a audit) {
 	sb.WriteString("    @Select(\"\"\"\n")
 	sb.WriteString("        SELECT\n")
-	columnNames := filterColumns(rowsData, skipPrefixes, false)
+	columnNames := filterColumns(rowsData, a, false)
 	writeColumnList(sb, columnNames)
 
 	removeNumberOfLines(sb, 1)
 
 	sb.WriteString(fmt.Sprintf("\n        FROM %s\n", tableName))
+	if a.notDeleted != "" {
+		sb.WriteString(fmt.Sprintf("        WHERE %s\n", a.notDeleted))
+	}
 	sb.WriteString("        \"\"\")\n")
 
 	sb.WriteString(fmt.Sprintf(
@@ -89,49 +107,29 @@ func (d *XmlAnnotation) writeSelectStatement(sb *strings.Builder, interfaceName,
 }
 
 func (d *XmlAnnotation) writeInsertStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
+	rowsData []domain.SqlData, a audit) {
 	sb.WriteString(fmt.Sprintf("    @Insert(\"\"\"\n        INSERT INTO %s (\n", tableName))
-	insertColumns := filterColumns(rowsData, skipPrefixes, true)
-	writeColumnList(sb, insertColumns)
-
-	sb.WriteString("          insert_ip,\n")
-	sb.WriteString("          insert_user_id,\n")
-	sb.WriteString("          insert_dtm\n")
+	insertColumns := filterColumns(rowsData, a, true)
+	writeList(sb, "          ", a.insertColumns(insertColumns))
 	sb.WriteString("        ) VALUES (\n")
-
-	for _, row := range insertColumns {
-		sb.WriteString(fmt.Sprintf("            #{%s},\n", common.ToCamelCase(row.ColumnName)))
-	}
-	sb.WriteString("            #{insertIp},\n")
-	sb.WriteString("            #{insertUserId},\n")
-	sb.WriteString("            CURRENT_TIMESTAMP(6)\n")
+	writeList(sb, "            ", a.insertValues(insertColumns, ""))
 	sb.WriteString("        )\n")
 	sb.WriteString("        \"\"\")\n")
 	sb.WriteString(fmt.Sprintf("    int insert%s(%sDto dto);\n\n", interfaceName, interfaceName))
 }
 
 func (d *XmlAnnotation) writeUpdateStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData, skipPrefixes []string) {
+	rowsData []domain.SqlData, a audit) {
 	sb.WriteString(fmt.Sprintf(
 		"    @Update(\"\"\"\n        UPDATE %s\n        SET\n",
 		tableName,
 	))
 
-	updateColumns := filterColumns(rowsData, skipPrefixes, true)
-	for _, row := range updateColumns {
-		sb.WriteString(fmt.Sprintf(
-			"            %s = #{%s},\n",
-			row.ColumnName,
-			common.ToCamelCase(row.ColumnName),
-		))
-	}
-	sb.WriteString("            update_ip = #{updateIp},\n")
-	sb.WriteString("            update_user_id = #{updateUserId},\n")
-	sb.WriteString("            update_dtm = CURRENT_TIMESTAMP(6)\n")
+	writeList(sb, "            ", a.updateSet(filterColumns(rowsData, a, true)))
 
-	sb.WriteString("        WHERE TRUE\n")
+	sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(d.dbType)))
 
-	primaryKeys := getPrimaryKeys(rowsData)
+	primaryKeys := common.PrimaryKeys(rowsData)
 	for _, pk := range primaryKeys {
 		sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n", pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
 	}
@@ -140,14 +138,63 @@ func (d *XmlAnnotation) writeUpdateStatement(sb *strings.Builder, interfaceName,
 }
 
 func (d *XmlAnnotation) writeDeleteStatement(sb *strings.Builder, interfaceName, tableName string,
-	rowsData []domain.SqlData) {
-	sb.WriteString(fmt.Sprintf("    @Delete(\"\"\"\n        DELETE\n        FROM %s\n        WHERE TRUE\n", tableName))
+	rowsData []domain.SqlData, a audit) {
+	// Soft deletes mark the row instead of removing it.
+	if a.deleted != nil {
+		sb.WriteString(fmt.Sprintf("    @Delete(\"\"\"\n        UPDATE %s\n        SET\n", tableName))
+		writeList(sb, "            ", a.softDeleteSet(true))
+		sb.WriteString(fmt.Sprintf("        WHERE %s\n", alwaysTrue(d.dbType)))
+	} else {
+		sb.WriteString(fmt.Sprintf("    @Delete(\"\"\"\n        DELETE\n        FROM %s\n        WHERE %s\n", tableName, alwaysTrue(d.dbType)))
+	}
 
-	primaryKeys := getPrimaryKeys(rowsData)
+	primaryKeys := common.PrimaryKeys(rowsData)
 	for _, pk := range primaryKeys {
 		sb.WriteString(fmt.Sprintf("            AND %s = #{%s}\n", pk.ColumnName, common.ToCamelCase(pk.ColumnName)))
 	}
 	sb.WriteString("        \"\"\")\n")
 
-	sb.WriteString(fmt.Sprintf("    int delete%s(%sDto dto);\n\n", interfaceName))
+	sb.WriteString(fmt.Sprintf("    int delete%s(%sDto dto);\n\n", interfaceName, interfaceName))
+}
+
+// writeResults maps snake_case columns onto the response properties. The
+// first statement declares the mapping and later ones refer to it by id.
+func (d *XmlAnnotation) writeResults(sb *strings.Builder, interfaceName string, rowsData []domain.SqlData,
+	a audit, declared bool) {
+	if declared {
+		sb.WriteString(fmt.Sprintf("    @ResultMap(\"%sResultMap\")\n", interfaceName))
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf("    @Results(id = \"%sResultMap\", value = {\n", interfaceName))
+	columns := filterColumns(rowsData, a, false)
+	for i, row := range columns {
+		id := ""
+		if common.IsPrimaryKey(row) {
+			id = "id = true, "
+		}
+		sb.WriteString(fmt.Sprintf("        @Result(%scolumn = \"%s\", property = \"%s\")",
+			id, row.ColumnName, common.ToCamelCase(row.ColumnName)))
+		if i < len(columns)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("    })\n")
+}
+
+// writeDynamicStatement wraps statements that use MyBatis tags in a <script>
+// block, which annotations need to parse them.
+func (d *XmlAnnotation) writeDynamicStatement(sb *strings.Builder, statement dynamicStatement) {
+	annotation := strings.ToUpper(statement.Kind[:1]) + statement.Kind[1:]
+	sb.WriteString(fmt.Sprintf("    @%s(\"\"\"\n", annotation))
+	if statement.Script {
+		sb.WriteString("        <script>\n")
+	}
+	sb.WriteString(statement.Sql)
+	if statement.Script {
+		sb.WriteString("        </script>\n")
+	}
+	sb.WriteString("        \"\"\")\n")
+	sb.WriteString(fmt.Sprintf("    %s;\n\n", statement.Method))
 }
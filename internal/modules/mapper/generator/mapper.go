@@ -6,5 +6,5 @@ import (
 )
 
 type Mapper interface {
-	Generate(rows *sql.Rows, req domain.MapperRequest, tbN string) (string, error)
+	Generate(rows *sql.Rows, req domain.MapperRequest, tbN string, dbType string) (string, error)
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/khanalsaroj/typegen-server/internal/domain"
@@ -30,6 +31,12 @@ func (h *Handler) GenerateMapper(c *gin.Context) {
 	}
 
 	result, err := h.service.Generate(c, req)
+	if errors.Is(err, domain.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
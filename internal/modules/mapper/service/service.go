@@ -2,16 +2,21 @@ package service
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
 	"github.com/khanalsaroj/typegen-server/internal/domain"
 	"github.com/khanalsaroj/typegen-server/internal/modules/connection"
+	"github.com/khanalsaroj/typegen-server/internal/modules/convention"
 	"github.com/khanalsaroj/typegen-server/internal/modules/helper"
 	"github.com/khanalsaroj/typegen-server/internal/modules/mapper/generator"
+	"gorm.io/gorm"
 )
 
 type MprService struct {
 	ConnectionService *connection.Service
+	ConventionService *convention.Service
 }
 
 func (s *MprService) Generate(c *gin.Context, req domain.MapperRequest) (string, error) {
@@ -34,10 +39,22 @@ func (s *MprService) Generate(c *gin.Context, req domain.MapperRequest) (string,
 		return "", err
 	}
 
-	mapper, err := generator.NewGenerator(req)
+	auditConvention := domain.DefaultAuditConvention()
+	if req.ConventionId != 0 {
+		stored, err := s.ConventionService.GetByID(c.Request.Context(), req.ConventionId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", fmt.Errorf("audit convention %d: %w", req.ConventionId, domain.ErrNotFound)
+		}
+		if err != nil {
+			return "", err
+		}
+		auditConvention = *stored
+	}
+
+	mapper, err := generator.NewGenerator(req, auditConvention)
 	if err != nil {
 		return "", err
 	}
 
-	return mapper.Generate(cols, req, req.TableName)
+	return mapper.Generate(cols, req, req.TableName, connInfo.DbType)
 }
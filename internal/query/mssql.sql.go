@@ -41,8 +41,48 @@ const (
                 ELSE NULL
             END AS CHARACTER_MAXIMUM_LENGTH,
             t.name AS DATA_TYPE,
-            CASE WHEN pk.column_id IS NOT NULL THEN 'PRI' ELSE '' END AS COLUMN_KEY,
-            ep.value AS COLUMN_COMMENT
+            CASE
+                WHEN pk.column_id IS NOT NULL THEN 'PRI'
+                WHEN EXISTS (
+                    SELECT 1
+                      FROM sys.indexes ui
+                      JOIN sys.index_columns uic ON uic.object_id = ui.object_id
+                           AND uic.index_id = ui.index_id
+                     WHERE ui.object_id = tab.object_id
+                       AND ui.is_unique = 1
+                       AND ui.is_primary_key = 0
+                       AND uic.column_id = c.column_id
+                       AND (SELECT COUNT(*)
+                              FROM sys.index_columns k
+                             WHERE k.object_id = ui.object_id
+                               AND k.index_id = ui.index_id
+                               AND k.is_included_column = 0) = 1
+                ) THEN 'UNI'
+                ELSE ''
+            END AS COLUMN_KEY,
+            ep.value AS COLUMN_COMMENT,
+            CASE WHEN c.is_identity = 1 THEN 'YES' ELSE 'NO' END AS IS_IDENTITY,
+            CASE
+                WHEN c.is_computed = 1 OR t.name IN ('timestamp', 'rowversion') THEN 'YES'
+                ELSE 'NO'
+            END AS IS_GENERATED,
+            CAST(NULL AS NVARCHAR(MAX)) AS ENUM_VALUES,
+            (SELECT TOP 1 OBJECT_NAME(fkc.referenced_object_id)
+               FROM sys.foreign_key_columns fkc
+              WHERE fkc.parent_object_id = tab.object_id
+                AND fkc.parent_column_id = c.column_id) AS REFERENCED_TABLE_NAME,
+            (SELECT TOP 1 COL_NAME(fkc.referenced_object_id, fkc.referenced_column_id)
+               FROM sys.foreign_key_columns fkc
+              WHERE fkc.parent_object_id = tab.object_id
+                AND fkc.parent_column_id = c.column_id) AS REFERENCED_COLUMN_NAME,
+            CASE
+                WHEN t.name IN ('decimal', 'numeric', 'money', 'smallmoney') THEN CAST(c.precision AS INT)
+                ELSE NULL
+            END AS NUMERIC_PRECISION,
+            CASE
+                WHEN t.name IN ('decimal', 'numeric', 'money', 'smallmoney') THEN CAST(c.scale AS INT)
+                ELSE NULL
+            END AS NUMERIC_SCALE
         FROM sys.tables tab
         INNER JOIN sys.columns c ON tab.object_id = c.object_id
         INNER JOIN sys.types t ON c.user_type_id = t.user_type_id
@@ -32,8 +32,31 @@ const (
 		        CHARACTER_MAXIMUM_LENGTH,
 		        DATA_TYPE, 
 		        COLUMN_KEY, 
-		        COLUMN_COMMENT
-		 FROM INFORMATION_SCHEMA.COLUMNS 
+		        COLUMN_COMMENT,
+		        CASE WHEN EXTRA LIKE '%auto_increment%' THEN 'YES' ELSE 'NO' END AS IS_IDENTITY,
+		        CASE WHEN EXTRA LIKE '%VIRTUAL GENERATED%' OR EXTRA LIKE '%STORED GENERATED%'
+		             THEN 'YES' ELSE 'NO' END AS IS_GENERATED,
+		        CASE WHEN DATA_TYPE = 'enum'
+		             THEN REPLACE(SUBSTRING(COLUMN_TYPE, LOCATE('(', COLUMN_TYPE) + 1,
+		                  LENGTH(COLUMN_TYPE) - LOCATE('(', COLUMN_TYPE) - 1), '''', '')
+		        END AS ENUM_VALUES,
+		        (SELECT k.REFERENCED_TABLE_NAME
+		           FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+		          WHERE k.TABLE_SCHEMA = c.TABLE_SCHEMA
+		            AND k.TABLE_NAME = c.TABLE_NAME
+		            AND k.COLUMN_NAME = c.COLUMN_NAME
+		            AND k.REFERENCED_TABLE_NAME IS NOT NULL
+		          LIMIT 1) AS REFERENCED_TABLE_NAME,
+		        (SELECT k.REFERENCED_COLUMN_NAME
+		           FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+		          WHERE k.TABLE_SCHEMA = c.TABLE_SCHEMA
+		            AND k.TABLE_NAME = c.TABLE_NAME
+		            AND k.COLUMN_NAME = c.COLUMN_NAME
+		            AND k.REFERENCED_TABLE_NAME IS NOT NULL
+		          LIMIT 1) AS REFERENCED_COLUMN_NAME,
+		        NUMERIC_PRECISION,
+		        NUMERIC_SCALE
+		 FROM INFORMATION_SCHEMA.COLUMNS c
 		 where table_schema = ?
 			 and table_name = ?
 			 order by ORDINAL_POSITION
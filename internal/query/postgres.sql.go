@@ -31,19 +31,71 @@ const (
 			c.is_nullable,
 			c.character_maximum_length,
 			c.udt_name as dataType,
-			CASE 
-				WHEN kcu.column_name IS NOT NULL THEN 'PRI'
+			CASE
+				WHEN EXISTS (
+					SELECT 1
+					FROM information_schema.table_constraints tc
+					JOIN information_schema.key_column_usage kcu
+					  ON kcu.constraint_name = tc.constraint_name
+					 AND kcu.table_schema = tc.table_schema
+					WHERE tc.constraint_type = 'PRIMARY KEY'
+					  AND kcu.table_schema = c.table_schema
+					  AND kcu.table_name = c.table_name
+					  AND kcu.column_name = c.column_name
+				) THEN 'PRI'
+				WHEN EXISTS (
+					SELECT 1
+					FROM information_schema.table_constraints tc
+					JOIN information_schema.key_column_usage kcu
+					  ON kcu.constraint_name = tc.constraint_name
+					 AND kcu.table_schema = tc.table_schema
+					WHERE tc.constraint_type = 'UNIQUE'
+					  AND kcu.table_schema = c.table_schema
+					  AND kcu.table_name = c.table_name
+					  AND kcu.column_name = c.column_name
+					  AND (
+						SELECT COUNT(*)
+						FROM information_schema.key_column_usage k2
+						WHERE k2.constraint_name = tc.constraint_name
+						  AND k2.table_schema = tc.table_schema
+					  ) = 1
+				) THEN 'UNI'
 				ELSE ''
 			END AS column_key,
-			pgd.description AS column_comment
+			pgd.description AS column_comment,
+			CASE
+				WHEN c.is_identity = 'YES' OR c.column_default LIKE 'nextval(%' THEN 'YES'
+				ELSE 'NO'
+			END AS is_identity,
+			CASE WHEN c.is_generated = 'ALWAYS' THEN 'YES' ELSE 'NO' END AS is_generated,
+			(
+				SELECT string_agg(e.enumlabel, ',' ORDER BY e.enumsortorder)
+				FROM pg_catalog.pg_type t
+				JOIN pg_catalog.pg_enum e ON e.enumtypid = t.oid
+				WHERE t.typname = c.udt_name
+			) AS enum_values,
+			fk.referenced_table_name,
+			fk.referenced_column_name,
+			c.numeric_precision,
+			c.numeric_scale
 		FROM information_schema.columns c
-		LEFT JOIN information_schema.key_column_usage kcu
-			   ON c.table_schema = kcu.table_schema
-			  AND c.table_name = kcu.table_name
-			  AND c.column_name = kcu.column_name
-		LEFT JOIN information_schema.table_constraints tc
-			   ON kcu.constraint_name = tc.constraint_name
-			  AND tc.constraint_type = 'PRIMARY KEY'
+		LEFT JOIN LATERAL (
+			SELECT
+				ccu.table_name AS referenced_table_name,
+				ccu.column_name AS referenced_column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu
+			  ON kcu.constraint_name = tc.constraint_name
+			 AND kcu.table_schema = tc.table_schema
+			JOIN information_schema.constraint_column_usage ccu
+			  ON ccu.constraint_name = tc.constraint_name
+			 AND ccu.constraint_schema = tc.constraint_schema
+			WHERE tc.constraint_type = 'FOREIGN KEY'
+			  AND kcu.table_schema = c.table_schema
+			  AND kcu.table_name = c.table_name
+			  AND kcu.column_name = c.column_name
+			LIMIT 1
+		) fk ON TRUE
 		LEFT JOIN pg_catalog.pg_class pc
 			   ON pc.relname = c.table_name
 		LEFT JOIN pg_catalog.pg_description pgd
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/khanalsaroj/typegen-server/internal/modules/connection"
+	"github.com/khanalsaroj/typegen-server/internal/modules/convention"
 	"github.com/khanalsaroj/typegen-server/internal/modules/health"
 	"github.com/khanalsaroj/typegen-server/internal/pkg/crypto"
 
@@ -98,8 +99,22 @@ func (s *Server) setupRoutes() {
 			typeGroup.POST("", typeHandler.GenerateType)
 		}
 
+		conventionRepo := convention.NewRepository(s.db)
+		conventionService := convention.NewService(conventionRepo)
+		conventionHandler := convention.NewHandler(conventionService)
+
+		conventionGroup := v1.Group("/convention")
+		{
+			conventionGroup.POST("", conventionHandler.Create)
+			conventionGroup.GET("/:id", conventionHandler.GetByID)
+			conventionGroup.GET("", conventionHandler.List)
+			conventionGroup.PUT("/:id", conventionHandler.Update)
+			conventionGroup.DELETE("/:id", conventionHandler.Delete)
+		}
+
 		mprSvc := &mprServicePkg.MprService{
 			ConnectionService: dbService,
+			ConventionService: conventionService,
 		}
 		mprHandler := mprHandlerPkg.New(mprSvc)
 